@@ -0,0 +1,65 @@
+package cardano
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mgpai22/gogenesis/internal/address"
+)
+
+// ParseAddress decodes a base or enterprise address written in bech32, such as
+// addr1... or addr_test1... Its network is not recorded; Plutus data leaves it out.
+func ParseAddress(bech32 string) (Address, error) {
+	_, payment, stake, err := address.Parse(bech32)
+	if err != nil {
+		return Address{}, err
+	}
+	a := Address{PaymentCredential: fromAddressCredential(payment)}
+	if stake != nil {
+		var inline StakeCredential = InlineCredential{Credential: fromAddressCredential(*stake)}
+		a.StakeCredential = &inline
+	}
+	return a, nil
+}
+
+// Bech32 returns the bech32 encoding of a on the named network: mainnet, preprod or
+// preview. Addresses with a stake pointer have no such encoding here.
+func (a Address) Bech32(network string) (string, error) {
+	n, ok := address.LookupNetwork(network)
+	if !ok {
+		return "", fmt.Errorf("unknown network %q", network)
+	}
+	payment, err := toAddressCredential(a.PaymentCredential)
+	if err != nil {
+		return "", err
+	}
+	if a.StakeCredential == nil {
+		return address.Enterprise(n, payment), nil
+	}
+	inline, ok := (*a.StakeCredential).(InlineCredential)
+	if !ok {
+		return "", errors.New("addresses with a pointer stake credential cannot be encoded in bech32")
+	}
+	stake, err := toAddressCredential(inline.Credential)
+	if err != nil {
+		return "", err
+	}
+	return address.Base(n, payment, stake), nil
+}
+
+func fromAddressCredential(c address.Credential) Credential {
+	if c.Script {
+		return ScriptCredential{Hash: c.Hash}
+	}
+	return VerificationKeyCredential{Hash: c.Hash}
+}
+
+func toAddressCredential(c Credential) (address.Credential, error) {
+	switch c := c.(type) {
+	case VerificationKeyCredential:
+		return address.Credential{Hash: c.Hash}, nil
+	case ScriptCredential:
+		return address.Credential{Hash: c.Hash, Script: true}, nil
+	}
+	return address.Credential{}, errors.New("address with a nil credential")
+}
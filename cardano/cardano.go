@@ -0,0 +1,168 @@
+// Package cardano declares Go types for the Cardano ledger types of the Aiken standard
+// library. Code generated by gogenesis binds the blueprint definitions of these types to
+// them, so values of packages generated from different blueprints can be exchanged.
+package cardano
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Credential is the hash of a verification key or of a script: a VerificationKeyCredential
+// or a ScriptCredential.
+type Credential interface {
+	PlutusData() (plutusdata.Data, error)
+	MarshalCBOR() ([]byte, error)
+	isCredential()
+}
+
+// VerificationKeyCredential is the credential of a verification key, by hash.
+type VerificationKeyCredential struct {
+	Hash []byte
+}
+
+// ScriptCredential is the credential of a script, by hash.
+type ScriptCredential struct {
+	Hash []byte
+}
+
+// StakeCredential designates the stake of an address: an InlineCredential or a
+// PointerCredential.
+type StakeCredential interface {
+	PlutusData() (plutusdata.Data, error)
+	MarshalCBOR() ([]byte, error)
+	isStakeCredential()
+}
+
+// InlineCredential is a stake credential given by its Credential.
+type InlineCredential struct {
+	Credential Credential
+}
+
+// PointerCredential is a stake credential given by the location of the certificate that
+// registered it on-chain.
+type PointerCredential struct {
+	SlotNumber       *big.Int
+	TransactionIndex *big.Int
+	CertificateIndex *big.Int
+}
+
+// Address is a Cardano address: a payment credential and an optional stake credential.
+type Address struct {
+	PaymentCredential Credential
+	StakeCredential   *StakeCredential
+}
+
+// OutputReference is a unique reference to a transaction output.
+type OutputReference struct {
+	TransactionID []byte
+	OutputIndex   *big.Int
+}
+
+func (VerificationKeyCredential) isCredential() {}
+func (ScriptCredential) isCredential()          {}
+func (InlineCredential) isStakeCredential()     {}
+func (PointerCredential) isStakeCredential()    {}
+
+// PlutusData returns the Plutus data representation of c.
+func (c VerificationKeyCredential) PlutusData() (plutusdata.Data, error) {
+	return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{plutusdata.Bytes(c.Hash)}}, nil
+}
+
+// PlutusData returns the Plutus data representation of c.
+func (c ScriptCredential) PlutusData() (plutusdata.Data, error) {
+	return plutusdata.Constr{Index: 1, Fields: []plutusdata.Data{plutusdata.Bytes(c.Hash)}}, nil
+}
+
+// PlutusData returns the Plutus data representation of c.
+func (c InlineCredential) PlutusData() (plutusdata.Data, error) {
+	credential, err := credentialData(c.Credential)
+	if err != nil {
+		return nil, err
+	}
+	return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{credential}}, nil
+}
+
+// PlutusData returns the Plutus data representation of c.
+func (c PointerCredential) PlutusData() (plutusdata.Data, error) {
+	fields := make([]plutusdata.Data, 0, 3)
+	for _, n := range []*big.Int{c.SlotNumber, c.TransactionIndex, c.CertificateIndex} {
+		if n == nil {
+			return nil, errors.New("pointer credential with a nil index")
+		}
+		fields = append(fields, plutusdata.Integer{Value: n})
+	}
+	return plutusdata.Constr{Index: 1, Fields: fields}, nil
+}
+
+// PlutusData returns the Plutus data representation of a.
+func (a Address) PlutusData() (plutusdata.Data, error) {
+	payment, err := credentialData(a.PaymentCredential)
+	if err != nil {
+		return nil, err
+	}
+	stake := plutusdata.Data(plutusdata.Constr{Index: 1, Fields: []plutusdata.Data{}})
+	if a.StakeCredential != nil {
+		if *a.StakeCredential == nil {
+			return nil, errors.New("address with a nil stake credential")
+		}
+		inner, err := (*a.StakeCredential).PlutusData()
+		if err != nil {
+			return nil, err
+		}
+		stake = plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{inner}}
+	}
+	return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{payment, stake}}, nil
+}
+
+// PlutusData returns the Plutus data representation of r.
+func (r OutputReference) PlutusData() (plutusdata.Data, error) {
+	if r.OutputIndex == nil {
+		return nil, errors.New("output reference with a nil output index")
+	}
+	return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{
+		plutusdata.Bytes(r.TransactionID),
+		plutusdata.Integer{Value: r.OutputIndex},
+	}}, nil
+}
+
+// MarshalCBOR returns the CBOR encoding of c as Plutus data.
+func (c VerificationKeyCredential) MarshalCBOR() ([]byte, error) { return marshal(c) }
+
+// MarshalCBOR returns the CBOR encoding of c as Plutus data.
+func (c ScriptCredential) MarshalCBOR() ([]byte, error) { return marshal(c) }
+
+// MarshalCBOR returns the CBOR encoding of c as Plutus data.
+func (c InlineCredential) MarshalCBOR() ([]byte, error) { return marshal(c) }
+
+// MarshalCBOR returns the CBOR encoding of c as Plutus data.
+func (c PointerCredential) MarshalCBOR() ([]byte, error) { return marshal(c) }
+
+// MarshalCBOR returns the CBOR encoding of a as Plutus data.
+func (a Address) MarshalCBOR() ([]byte, error) { return marshal(a) }
+
+// MarshalCBOR returns the CBOR encoding of r as Plutus data.
+func (r OutputReference) MarshalCBOR() ([]byte, error) { return marshal(r) }
+
+// credentialData returns the Plutus data representation of a credential, which must not
+// be nil.
+func credentialData(c Credential) (plutusdata.Data, error) {
+	if c == nil {
+		return nil, errors.New("nil credential")
+	}
+	return c.PlutusData()
+}
+
+// marshal returns the CBOR encoding of the Plutus data representation of v.
+func marshal(v interface {
+	PlutusData() (plutusdata.Data, error)
+}) ([]byte, error) {
+	d, err := v.PlutusData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	return plutusdata.Encode(d), nil
+}
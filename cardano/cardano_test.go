@@ -0,0 +1,130 @@
+package cardano
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+)
+
+const (
+	keyHash    = "00112233445566778899aabbccddeeff00112233445566778899aabb"
+	scriptHash = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestMarshalCBOR(t *testing.T) {
+	var pointer StakeCredential = PointerCredential{SlotNumber: big.NewInt(1), TransactionIndex: big.NewInt(2), CertificateIndex: big.NewInt(3)}
+	var inline StakeCredential = InlineCredential{Credential: ScriptCredential{Hash: mustHex(scriptHash)}}
+	tests := []struct {
+		name  string
+		value interface{ MarshalCBOR() ([]byte, error) }
+		want  string
+	}{
+		{"enterprise address", Address{PaymentCredential: VerificationKeyCredential{Hash: mustHex(keyHash)}}, "d8799fd8799f581c" + keyHash + "ffd87a80ff"},
+		{"base address", Address{PaymentCredential: VerificationKeyCredential{Hash: mustHex(keyHash)}, StakeCredential: &inline}, "d8799fd8799f581c" + keyHash + "ffd8799fd8799fd87a9f581c" + scriptHash + "ffffffff"},
+		{"pointer address", Address{PaymentCredential: ScriptCredential{Hash: mustHex(scriptHash)}, StakeCredential: &pointer}, "d8799fd87a9f581c" + scriptHash + "ffd8799fd87a9f010203ffffff"},
+		{"output reference", OutputReference{TransactionID: []byte{0xff}, OutputIndex: big.NewInt(3)}, "d8799f41ff03ff"},
+		{"empty value", Value{}, "a0"},
+		// Policies and asset names are sorted by their bytes, Ada first.
+		{"value", Value{scriptHash: {"746f6b656e": big.NewInt(-42), "": big.NewInt(1)}, "": {"": big.NewInt(2000000)}}, "a240a1401a001e8480581c" + scriptHash + "a2400145746f6b656e3829"},
+		{"lovelace", Lovelace(5), "a140a14005"},
+		{"posix time", POSIXTime(1700000000000), "1b0000018bcfe56800"},
+	}
+	for _, tt := range tests {
+		got, err := tt.value.MarshalCBOR()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("%s = %x, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMarshalCBORErrors(t *testing.T) {
+	var none StakeCredential
+	tests := []struct {
+		name  string
+		value interface{ MarshalCBOR() ([]byte, error) }
+	}{
+		{"nil payment credential", Address{}},
+		{"nil stake credential", Address{PaymentCredential: ScriptCredential{}, StakeCredential: &none}},
+		{"nil inline credential", InlineCredential{}},
+		{"nil pointer index", PointerCredential{SlotNumber: big.NewInt(1)}},
+		{"nil output index", OutputReference{}},
+		{"policy not in hex", Value{"xyz": {}}},
+		{"asset name in upper case", Value{"": {"AB": big.NewInt(1)}}},
+		{"nil quantity", Value{"": {"": nil}}},
+	}
+	for _, tt := range tests {
+		if _, err := tt.value.MarshalCBOR(); err == nil {
+			t.Errorf("%s: encoded without an error", tt.name)
+		}
+	}
+}
+
+func TestAddressBech32(t *testing.T) {
+	tests := []struct {
+		network string
+		bech32  string
+	}{
+		// Enterprise and base addresses of CIP-19's test vectors.
+		{"mainnet", "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"},
+		{"preview", "addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr"},
+		{"mainnet", "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh"},
+	}
+	for _, tt := range tests {
+		a, err := ParseAddress(tt.bech32)
+		if err != nil {
+			t.Errorf("ParseAddress(%s): %v", tt.bech32, err)
+			continue
+		}
+		got, err := a.Bech32(tt.network)
+		if err != nil {
+			t.Errorf("%s: Bech32: %v", tt.bech32, err)
+			continue
+		}
+		if got != tt.bech32 {
+			t.Errorf("ParseAddress(%s).Bech32(%s) = %s", tt.bech32, tt.network, got)
+		}
+	}
+
+	a, err := ParseAddress("addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := a.PaymentCredential.(ScriptCredential); !ok {
+		t.Errorf("payment credential = %#v, want a script", a.PaymentCredential)
+	}
+	if inline, ok := (*a.StakeCredential).(InlineCredential); !ok || hex.EncodeToString(inline.Credential.(VerificationKeyCredential).Hash) != "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251" {
+		t.Errorf("stake credential = %#v, want the key 337b62cf...", *a.StakeCredential)
+	}
+
+	var pointer StakeCredential = PointerCredential{}
+	if _, err := (Address{PaymentCredential: a.PaymentCredential, StakeCredential: &pointer}).Bech32("mainnet"); err == nil {
+		t.Error("an address with a stake pointer was encoded in bech32")
+	}
+	if _, err := a.Bech32("devnet"); err == nil {
+		t.Error("an address was encoded for an unknown network")
+	}
+}
+
+func TestPOSIXTime(t *testing.T) {
+	at := time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)
+	p := NewPOSIXTime(at)
+	if p != 1700000000123 {
+		t.Errorf("NewPOSIXTime(%v) = %d, want 1700000000123", at, p)
+	}
+	if want := at.Truncate(time.Millisecond); !p.Time().Equal(want) {
+		t.Errorf("Time() = %v, want %v", p.Time(), want)
+	}
+}
@@ -0,0 +1,90 @@
+package cardano
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Value holds quantities of assets, keyed by hex-encoded policy ID and then by
+// hex-encoded asset name. Ada has the empty policy ID and asset name, and its quantity
+// is in lovelace. On-chain, policies and asset names are in ascending order.
+type Value map[string]map[string]*big.Int
+
+// Lovelace returns the Value holding n lovelace.
+func Lovelace(n int64) Value {
+	return Value{"": {"": big.NewInt(n)}}
+}
+
+// PlutusData returns the Plutus data representation of v, a map of maps sorted by key.
+func (v Value) PlutusData() (plutusdata.Data, error) {
+	policies, err := sortedHexKeys(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy ID: %w", err)
+	}
+	m := make(plutusdata.Map, 0, len(policies))
+	for _, policy := range policies {
+		assets := v[hex.EncodeToString(policy)]
+		names, err := sortedHexKeys(assets)
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset name of policy %x: %w", policy, err)
+		}
+		inner := make(plutusdata.Map, 0, len(names))
+		for _, name := range names {
+			quantity := assets[hex.EncodeToString(name)]
+			if quantity == nil {
+				return nil, fmt.Errorf("nil quantity of asset %x.%x", policy, name)
+			}
+			inner = append(inner, plutusdata.Pair{Key: plutusdata.Bytes(name), Value: plutusdata.Integer{Value: quantity}})
+		}
+		m = append(m, plutusdata.Pair{Key: plutusdata.Bytes(policy), Value: inner})
+	}
+	return m, nil
+}
+
+// MarshalCBOR returns the CBOR encoding of v as Plutus data.
+func (v Value) MarshalCBOR() ([]byte, error) { return marshal(v) }
+
+// sortedHexKeys decodes the keys of m, which must be hex strings in lower case so that
+// each byte string has a single key, and sorts them.
+func sortedHexKeys[V any](m map[string]V) ([][]byte, error) {
+	keys := make([][]byte, 0, len(m))
+	for k := range m {
+		b, err := hex.DecodeString(k)
+		if err != nil {
+			return nil, err
+		}
+		if hex.EncodeToString(b) != k {
+			return nil, fmt.Errorf("%q is not in lower case", k)
+		}
+		keys = append(keys, b)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys, nil
+}
+
+// POSIXTime is a point in time, in milliseconds since the Unix epoch.
+type POSIXTime int64
+
+// NewPOSIXTime returns the POSIXTime of t, truncated to the millisecond.
+func NewPOSIXTime(t time.Time) POSIXTime {
+	return POSIXTime(t.UnixMilli())
+}
+
+// Time returns the time p stands for.
+func (p POSIXTime) Time() time.Time {
+	return time.UnixMilli(int64(p))
+}
+
+// PlutusData returns the Plutus data representation of p.
+func (p POSIXTime) PlutusData() (plutusdata.Data, error) {
+	return plutusdata.NewInteger(int64(p)), nil
+}
+
+// MarshalCBOR returns the CBOR encoding of p as Plutus data.
+func (p POSIXTime) MarshalCBOR() ([]byte, error) { return marshal(p) }
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/mgpai22/gogenesis/internal/encode"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// applyParamsCommand applies a validator to parameters given as JSON and prints the
+// compiled code and hash of the applied script.
+func applyParamsCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis apply-params", flag.ExitOnError)
+	jsonPath := flag.String("json", "", "Path to plutus.json")
+	validator := flag.String("validator", "", "Title of the validator to apply")
+	params := flag.String("params", "", "JSON array of parameters, or @file to read it from a file")
+	flag.Parse(args)
+
+	if *jsonPath == "" || *validator == "" || *params == "" {
+		log.Fatal("Error: -json, -validator and -params flags are required")
+	}
+	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *jsonPath, err)
+	}
+	var v *parser.PlutusValidator
+	for i := range plutusData.Validators {
+		if plutusData.Validators[i].Title == *validator {
+			v = &plutusData.Validators[i]
+			break
+		}
+	}
+	if v == nil {
+		log.Fatalf("Validator %s not found", *validator)
+	}
+
+	value, err := encode.ParseJSON(jsonArgument("parameters", *params))
+	if err != nil {
+		log.Fatalf("Failed to parse parameters: %v", err)
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		log.Fatal("Parameters must be a JSON array")
+	}
+	if len(values) > len(v.Parameters) {
+		log.Fatalf("Validator %s takes %d parameters, got %d", v.Title, len(v.Parameters), len(values))
+	}
+
+	types, err := ir.Build(plutusData.Definitions)
+	if err != nil {
+		log.Fatalf("Invalid blueprint %s: %v", *jsonPath, err)
+	}
+	// Fewer parameters than declared apply the validator partially.
+	constants := make([]uplc.Constant, len(values))
+	for i, value := range values {
+		if constants[i], err = encode.Constant(value, types.Convert(v.Parameters[i].Schema), types); err != nil {
+			log.Fatalf("Parameter %s: %v", parameterName(v.Parameters[i], i), err)
+		}
+	}
+
+	script, err := uplc.ParseScript(v.CompiledCode, plutusData.PlutusVersion())
+	if err != nil {
+		log.Fatalf("Failed to decode %s: %v", v.Title, err)
+	}
+	applied := script.ApplyConstants(constants...)
+	hash, err := applied.Hash()
+	if err != nil {
+		log.Fatalf("Failed to hash %s: %v", v.Title, err)
+	}
+	fmt.Printf("compiledCode: %s\n", applied.CompiledCode())
+	fmt.Printf("hash: %s\n", hex.EncodeToString(hash))
+}
+
+// parameterName returns the title of the i-th parameter, or its position if untitled.
+func parameterName(p parser.PlutusArgument, i int) string {
+	if p.Title != "" {
+		return p.Title
+	}
+	return fmt.Sprintf("%d", i+1)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/evaluate"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// evalCommand runs a validator against a datum, redeemer and script context given as
+// JSON, and prints whether it succeeded and the execution units it spent.
+func evalCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis eval", flag.ExitOnError)
+	jsonPath := flag.String("json", "", "Path to plutus.json")
+	validator := flag.String("validator", "", "Title of the validator to run")
+	params := flag.String("params", "", "JSON array of parameters, or @file")
+	datum := flag.String("datum", "", "Datum as JSON, or @file")
+	redeemer := flag.String("redeemer", "", "Redeemer as JSON, or @file")
+	context := flag.String("context", "", "Whole script context in detailed JSON, or @file; a mock context is built if empty")
+	txInfo := flag.String("tx-info", "", "Transaction of the mock script context in detailed JSON, or @file")
+	outRef := flag.String("out-ref", "", "Output spent by the mock script context, as txid#index")
+	maxCPU := flag.Int64("max-cpu", uplc.DefaultBudget.CPU, "CPU budget")
+	maxMem := flag.Int64("max-mem", uplc.DefaultBudget.Mem, "Memory budget")
+	flag.Usage = func() {
+		out := flag.Output()
+		fmt.Fprintf(out, "Usage of %s:\n", flag.Name())
+		flag.PrintDefaults()
+		fmt.Fprint(out, evalLimits)
+	}
+	flag.Parse(args)
+
+	if *jsonPath == "" || *validator == "" {
+		log.Fatal("Error: -json and -validator flags are required")
+	}
+	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *jsonPath, err)
+	}
+	var v *parser.PlutusValidator
+	for i := range plutusData.Validators {
+		if plutusData.Validators[i].Title == *validator {
+			v = &plutusData.Validators[i]
+			break
+		}
+	}
+	if v == nil {
+		log.Fatalf("Validator %s not found", *validator)
+	}
+
+	inputs := evaluate.Inputs{
+		Params:   jsonArgument("parameters", *params),
+		Datum:    jsonArgument("datum", *datum),
+		Redeemer: jsonArgument("redeemer", *redeemer),
+		Context:  jsonArgument("context", *context),
+		TxInfo:   jsonArgument("transaction", *txInfo),
+		OutRef:   *outRef,
+	}
+	outcome, err := evaluate.Run(plutusData, *v, inputs, uplc.Budget{CPU: *maxCPU, Mem: *maxMem})
+	if err != nil {
+		log.Fatalf("Failed to run %s: %v", v.Title, err)
+	}
+	for _, line := range outcome.Logs {
+		fmt.Printf("trace: %s\n", line)
+	}
+	if outcome.Err != nil {
+		fmt.Printf("result: failure: %v\n", outcome.Err)
+	} else {
+		fmt.Println("result: success")
+	}
+	fmt.Printf("cpu: %d\n", outcome.Budget.CPU)
+	fmt.Printf("mem: %d\n", outcome.Budget.Mem)
+	if outcome.Err != nil {
+		os.Exit(1)
+	}
+}
+
+// evalLimits describes what the evaluator cannot run, after the flags in eval -h.
+const evalLimits = `
+Limits:
+  Only Plutus V3 validators can be run, costed with the V3 cost model. V1 and V2
+  have their own cost models and builtin semantics, which are not implemented.
+  Validators using these builtins are rejected before they run: the secp256k1
+  signatures, BLS12-381, integerToByteString and byteStringToInteger, the bitwise
+  operations, ripemd_160, expModInteger, dropList and arrays.
+  Without -context, script contexts can only be mocked for spend, mint and
+  withdraw validators.
+`
+
+// jsonArgument returns the JSON text of a flag, read from a file if it starts with @,
+// or nil if the flag is empty.
+func jsonArgument(name, value string) []byte {
+	if value == "" {
+		return nil
+	}
+	if !strings.HasPrefix(value, "@") {
+		return []byte(value)
+	}
+	text, err := os.ReadFile(strings.TrimPrefix(value, "@"))
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", name, err)
+	}
+	return text
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// graphCommand prints the type dependency graph of a blueprint as Mermaid or DOT.
+func graphCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis graph", flag.ExitOnError)
+	jsonPath := flag.String("json", "", "Path to plutus.json")
+	format := flag.String("format", "mermaid", "Output format (mermaid, dot)")
+	validator := flag.String("validator", "", "Validator title to root the graph at; all definitions are shown if empty")
+	argument := flag.String("argument", "", "Argument of -validator to root the graph at (datum, redeemer, parameter); all arguments if empty")
+	flag.Parse(args)
+
+	if *jsonPath == "" {
+		log.Fatal("Error: -json flag is required")
+	}
+	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *jsonPath, err)
+	}
+	types, err := generator.Resolve(plutusData)
+	if err != nil {
+		log.Fatalf("Invalid blueprint %s: %v", *jsonPath, err)
+	}
+	graph, err := generator.DependencyGraph(plutusData, types, *validator, *argument)
+	if err != nil {
+		log.Fatalf("Failed to build dependency graph: %v", err)
+	}
+	switch *format {
+	case "mermaid":
+		fmt.Print(graph.Mermaid())
+	case "dot":
+		fmt.Print(graph.DOT())
+	default:
+		log.Fatalf("Unknown format %s", *format)
+	}
+}
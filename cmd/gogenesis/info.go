@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mgpai22/gogenesis/internal/address"
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// infoCommand prints the hashes, policy IDs and addresses of the validators of a
+// blueprint, checking the hashes against their compiled code.
+func infoCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis info", flag.ExitOnError)
+	jsonPath := flag.String("json", "", "Path to plutus.json")
+	validator := flag.String("validator", "", "Title of the validator to describe; all validators if empty")
+	network := flag.String("network", "", "Network to derive addresses for (mainnet, preprod, preview); all networks if empty")
+	stakeKey := flag.String("stake-key", "", "Stake key hash in hex, to derive base addresses delegating to it")
+	stakeScript := flag.String("stake-script", "", "Stake script hash in hex, to derive base addresses delegating to it")
+	flag.Parse(args)
+
+	if *jsonPath == "" {
+		log.Fatal("Error: -json flag is required")
+	}
+	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *jsonPath, err)
+	}
+
+	networks := address.Networks
+	if *network != "" {
+		n, ok := address.LookupNetwork(*network)
+		if !ok {
+			log.Fatalf("Unknown network %s", *network)
+		}
+		networks = []address.Network{n}
+	}
+	var stake *address.Credential
+	if *stakeKey != "" && *stakeScript != "" {
+		log.Fatal("Error: -stake-key and -stake-script are mutually exclusive")
+	}
+	for _, s := range []struct {
+		hash   string
+		script bool
+	}{{*stakeKey, false}, {*stakeScript, true}} {
+		if s.hash == "" {
+			continue
+		}
+		hash, err := hex.DecodeString(s.hash)
+		if err != nil || len(hash) != 28 {
+			log.Fatalf("Stake credential %s is not a 28-byte hash in hex", s.hash)
+		}
+		stake = &address.Credential{Hash: hash, Script: s.script}
+	}
+
+	found, failed := false, false
+	for _, v := range plutusData.Validators {
+		if *validator != "" && v.Title != *validator {
+			continue
+		}
+		found = true
+		if !printInfo(v, plutusData.PlutusVersion(), networks, stake) {
+			failed = true
+		}
+	}
+	if !found {
+		log.Fatalf("Validator %s not found", *validator)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// printInfo describes validator v, reporting whether its hash checks out.
+func printInfo(v parser.PlutusValidator, plutusVersion string, networks []address.Network, stake *address.Credential) bool {
+	fmt.Println(v.Title)
+	if purpose := v.Purpose(); purpose != "" {
+		fmt.Printf("  purpose: %s\n", purpose)
+	}
+	fmt.Printf("  plutus version: %s\n", plutusVersion)
+	fmt.Printf("  size: %d bytes\n", len(v.CompiledCode)/2)
+	info, err := generator.ValidatorScriptInfo(v, plutusVersion)
+	if err != nil {
+		fmt.Printf("  error: %v\n", err)
+		return false
+	}
+	fmt.Printf("  hash: %s\n", info.Hash)
+	if len(v.Parameters) > 0 {
+		fmt.Printf("  parameters: %d; the hash and addresses change once they are applied with apply-params\n", len(v.Parameters))
+	}
+	if v.Purpose() == "mint" {
+		fmt.Printf("  policy id: %s\n", info.Hash)
+	}
+	hash, _ := hex.DecodeString(info.Hash)
+	payment := address.Credential{Hash: hash, Script: true}
+	for _, n := range networks {
+		fmt.Printf("  %s enterprise address: %s\n", n.Name, address.Enterprise(n, payment))
+		if stake != nil {
+			fmt.Printf("  %s base address: %s\n", n.Name, address.Base(n, payment, *stake))
+		}
+		if purpose := v.Purpose(); purpose == "withdraw" || purpose == "publish" {
+			fmt.Printf("  %s reward address: %s\n", n.Name, address.Reward(n, payment))
+		}
+	}
+	return true
+}
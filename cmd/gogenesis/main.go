@@ -4,34 +4,94 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/generator/docs"
 	"github.com/mgpai22/gogenesis/internal/generator/golang"
 	"github.com/mgpai22/gogenesis/internal/generator/typescript"
 	"github.com/mgpai22/gogenesis/internal/parser"
 )
 
+// commands are the subcommands of gogenesis. Without one, gogenesis generates code.
+var commands = map[string]func(args []string){
+	"apply-params": applyParamsCommand,
+	"eval":         evalCommand,
+	"graph":        graphCommand,
+	"info":         infoCommand,
+	"report":       reportCommand,
+	"version":      versionCommand,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			command(os.Args[2:])
+			return
+		}
+	}
+	generateCommand(os.Args[1:])
+}
+
+// generateCommand generates code for one or more blueprints.
+func generateCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis", flag.ExitOnError)
+
 	// CLI flags
-	jsonPath := flag.String("json", "", "Path to plutus.json")
+	jsonPath := flag.String("json", "", "Path to plutus.json (comma-separate several to generate them into one package)")
 	outPath := flag.String("out", "./generated", "Output directory for generated files")
-	lang := flag.String("lang", "typescript", "Target language (typescript, golang)")
-	flag.Parse()
+	lang := flag.String("lang", "typescript", "Target language (typescript, golang, docs)")
+	typesPath := flag.String("types", "", "Path to a JSON file with additional well-known type bindings")
+	wrappedRedeemers := flag.String("wrapped-redeemers", "", "Comma-separated definition references to treat as wrapped redeemers (ref=false disables detection for ref)")
+	validators := flag.String("validators", "", "Comma-separated validator titles or glob patterns; only these validators and the types they use are generated")
+	refs := flag.String("refs", "", "Comma-separated definition references to generate along with the selected validators")
+	exclude := flag.String("exclude", "", "Comma-separated glob patterns of definition references to generate as opaque Data")
+	scriptContext := flag.String("script-context", "", "Comma-separated Plutus versions (v2, v3) whose ScriptContext and ledger types are generated too")
+	propertyTests := flag.Bool("property-tests", false, "Also generate random value generators and CBOR roundtrip tests for every type")
+	flag.Parse(args)
 
 	if *jsonPath == "" {
 		log.Fatal("Error: -json flag is required")
 	}
 
+	filter := generator.Filter{
+		Validators: splitList(*validators),
+		Refs:       splitList(*refs),
+		Exclude:    splitList(*exclude),
+	}
+
 	// Parse plutus.json
-	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
-	if err != nil {
-		log.Fatalf("Failed to parse plutus.json: %v", err)
+	var projects []generator.Project
+	for _, path := range splitList(*jsonPath) {
+		plutusData, err := parser.ParsePlutusJSON(path)
+		if err != nil {
+			log.Fatalf("Failed to parse %s: %v", path, err)
+		}
+		if !filter.IsZero() {
+			if plutusData, err = filter.Apply(plutusData); err != nil {
+				log.Fatalf("Failed to filter %s: %v", path, err)
+			}
+		}
+		projects = append(projects, generator.Project{Name: projectName(path, plutusData), Schema: plutusData})
+	}
+
+	var err error
+	wellKnown := generator.DefaultWellKnownRegistry()
+	if *typesPath != "" {
+		wellKnown, err = generator.LoadWellKnownRegistry(*typesPath)
+		if err != nil {
+			log.Fatalf("Failed to load well-known types: %v", err)
+		}
 	}
 
 	var codeGen generator.CodeGenerator
 	switch *lang {
 	case "golang":
 		codeGen = golang.NewGoGenerator()
+	case "docs":
+		codeGen = docs.NewDocsGenerator()
 	default:
 		codeGen = typescript.NewTypeScriptGenerator()
 	}
@@ -39,11 +99,52 @@ func main() {
 	opts := generator.GeneratorOptions{
 		ReservedNames: nil, // uses defaults if nil
 		Language:      *lang,
+		WellKnown:     wellKnown,
+
+		WrappedRedeemers: parseWrappedRedeemers(*wrappedRedeemers),
+		ScriptContexts:   splitList(*scriptContext),
+		PropertyTests:    *propertyTests,
 	}
 	g := generator.NewGeneratorWithOptions(*outPath, opts, codeGen)
-	if err := g.Generate(plutusData); err != nil {
+	if len(projects) == 1 {
+		err = g.Generate(projects[0].Schema)
+	} else {
+		err = g.GenerateProjects(projects)
+	}
+	if err != nil {
 		log.Fatalf("Code generation failed: %v", err)
 	}
 
 	fmt.Println("Code generation completed successfully!")
 }
+
+// projectName names a blueprint after the last segment of its preamble title, such as
+// "market" for "acme/market", or else after the directory holding it.
+func projectName(path string, schema *parser.PlutusSchema) string {
+	if title := schema.Preamble.Title; title != "" {
+		return title[strings.LastIndex(title, "/")+1:]
+	}
+	return filepath.Base(filepath.Dir(path))
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// parseWrappedRedeemers parses the -wrapped-redeemers flag into the override map used by
+// the generators.
+func parseWrappedRedeemers(value string) map[string]bool {
+	overrides := make(map[string]bool)
+	for _, entry := range splitList(value) {
+		ref, setting, found := strings.Cut(entry, "=")
+		overrides[ref] = !found || setting != "false"
+	}
+	return overrides
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// reportCommand prints the script sizes of the validators of a blueprint, checked
+// against the protocol limits and compared to a previous blueprint. It exits with an
+// error if a limit is exceeded.
+func reportCommand(args []string) {
+	flag := flag.NewFlagSet("gogenesis report", flag.ExitOnError)
+	jsonPath := flag.String("json", "", "Path to plutus.json")
+	previousPath := flag.String("previous", "", "Path to a previous plutus.json to compare sizes with")
+	maxTxSize := flag.Int("max-tx-size", generator.DefaultSizeLimits.MaxTxSize, "Maximum transaction size in bytes, which bounds each script")
+	maxRefScriptSize := flag.Int("max-ref-script-size", generator.DefaultSizeLimits.MaxRefScriptSize, "Maximum total size in bytes of the scripts a transaction references")
+	flag.Parse(args)
+
+	if *jsonPath == "" {
+		log.Fatal("Error: -json flag is required")
+	}
+	plutusData, err := parser.ParsePlutusJSON(*jsonPath)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *jsonPath, err)
+	}
+	var previous *parser.PlutusSchema
+	if *previousPath != "" {
+		if previous, err = parser.ParsePlutusJSON(*previousPath); err != nil {
+			log.Fatalf("Failed to parse %s: %v", *previousPath, err)
+		}
+	}
+
+	report := generator.NewSizeReport(plutusData, previous, generator.SizeLimits{
+		MaxTxSize:        *maxTxSize,
+		MaxRefScriptSize: *maxRefScriptSize,
+	})
+	fmt.Print(report.Text())
+	if report.Exceeded() {
+		os.Exit(1)
+	}
+}
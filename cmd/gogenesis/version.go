@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mgpai22/gogenesis/internal/version"
+)
+
+// versionCommand prints the version of gogenesis.
+func versionCommand(args []string) {
+	fmt.Printf("gogenesis %s\n", version.GetVersionString())
+}
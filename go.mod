@@ -2,3 +2,7 @@ module github.com/mgpai22/gogenesis
 
 // Floor Go version of gogenesis (current - 2)
 go 1.21
+
+require golang.org/x/crypto v0.31.0
+
+require golang.org/x/sys v0.28.0 // indirect
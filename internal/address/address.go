@@ -0,0 +1,112 @@
+// Package address derives Shelley addresses of scripts, as specified by CIP-19, and
+// encodes them in bech32.
+package address
+
+import "fmt"
+
+// hashSize is the size of the credential hashes of an address.
+const hashSize = 28
+
+// Network is a Cardano network. Preprod and preview share the testnet network ID, so
+// their addresses are the same.
+type Network struct {
+	Name string
+	ID   byte
+}
+
+// Networks are the networks addresses are derived for, mainnet first.
+var Networks = []Network{
+	{Name: "mainnet", ID: 1},
+	{Name: "preprod", ID: 0},
+	{Name: "preview", ID: 0},
+}
+
+// LookupNetwork returns the network with the given name.
+func LookupNetwork(name string) (Network, bool) {
+	for _, n := range Networks {
+		if n.Name == name {
+			return n, true
+		}
+	}
+	return Network{}, false
+}
+
+// Credential is a payment or stake credential: the hash of a verification key or of a
+// script.
+type Credential struct {
+	Hash   []byte
+	Script bool
+}
+
+// Enterprise returns the address of payment on network, which has no stake credential.
+func Enterprise(network Network, payment Credential) string {
+	header := byte(0x60)
+	if payment.Script {
+		header = 0x70
+	}
+	return encode(network, "addr", header, payment.Hash)
+}
+
+// Base returns the address of payment on network, delegating to stake.
+func Base(network Network, payment, stake Credential) string {
+	header := byte(0x00)
+	if payment.Script {
+		header |= 0x10
+	}
+	if stake.Script {
+		header |= 0x20
+	}
+	return encode(network, "addr", header, payment.Hash, stake.Hash)
+}
+
+// Reward returns the reward address of stake on network.
+func Reward(network Network, stake Credential) string {
+	header := byte(0xe0)
+	if stake.Script {
+		header = 0xf0
+	}
+	return encode(network, "stake", header, stake.Hash)
+}
+
+// encode encodes an address of the given header and credential hashes. Addresses on
+// testnets have the human-readable part prefix_test.
+func encode(network Network, prefix string, header byte, hashes ...[]byte) string {
+	data := []byte{header | network.ID}
+	for _, h := range hashes {
+		data = append(data, h...)
+	}
+	if network.ID != 1 {
+		prefix += "_test"
+	}
+	return encodeBech32(prefix, data)
+}
+
+// Parse decodes a base or enterprise address, returning the ID of its network, its
+// payment credential and, for base addresses, its stake credential.
+func Parse(s string) (networkID byte, payment Credential, stake *Credential, err error) {
+	_, data, err := decodeBech32(s)
+	if err != nil {
+		return 0, Credential{}, nil, err
+	}
+	if len(data) == 0 {
+		return 0, Credential{}, nil, fmt.Errorf("invalid address %s: empty", s)
+	}
+	header := data[0]
+	networkID = header & 0x0f
+	kind := header >> 4
+	switch {
+	case kind <= 3:
+		if len(data) != 1+2*hashSize {
+			return 0, Credential{}, nil, fmt.Errorf("invalid address %s: wrong length", s)
+		}
+		stake = &Credential{Hash: data[1+hashSize:], Script: kind&2 != 0}
+	case kind == 6 || kind == 7:
+		if len(data) != 1+hashSize {
+			return 0, Credential{}, nil, fmt.Errorf("invalid address %s: wrong length", s)
+		}
+	default:
+		return 0, Credential{}, nil, fmt.Errorf("unsupported address %s: only base and enterprise addresses are supported", s)
+	}
+	payment = Credential{Hash: data[1 : 1+hashSize], Script: kind&1 != 0}
+	return networkID, payment, stake, nil
+}
@@ -0,0 +1,90 @@
+package address
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestBech32(t *testing.T) {
+	// The empty test vector of BIP-173; the others carry data not made of whole bytes.
+	if got := encodeBech32("a", nil); got != "a12uel5l" {
+		t.Errorf("encodeBech32(a) = %s, want a12uel5l", got)
+	}
+}
+
+// The addresses of CIP-19's test vectors.
+func TestAddresses(t *testing.T) {
+	script, _ := hex.DecodeString("c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f")
+	stakeKey, _ := hex.DecodeString("337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251")
+	scriptCred := Credential{Hash: script, Script: true}
+	keyCred := Credential{Hash: stakeKey}
+	mainnet, _ := LookupNetwork("mainnet")
+	preview, _ := LookupNetwork("preview")
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"enterprise mainnet", Enterprise(mainnet, scriptCred), "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"},
+		{"enterprise testnet", Enterprise(preview, scriptCred), "addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr"},
+		{"base script/script", Base(mainnet, scriptCred, scriptCred), "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g"},
+		{"base script/key", Base(mainnet, scriptCred, keyCred), "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh"},
+		{"reward key", Reward(mainnet, keyCred), "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"reward script", Reward(mainnet, scriptCred), "stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	script, _ := hex.DecodeString("c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f")
+	stakeKey, _ := hex.DecodeString("337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251")
+	scriptCred := Credential{Hash: script, Script: true}
+	keyCred := Credential{Hash: stakeKey}
+	mainnet, _ := LookupNetwork("mainnet")
+	preview, _ := LookupNetwork("preview")
+
+	tests := []struct {
+		name    string
+		address string
+		network Network
+		payment Credential
+		stake   *Credential
+	}{
+		{"enterprise mainnet", Enterprise(mainnet, scriptCred), mainnet, scriptCred, nil},
+		{"enterprise testnet", Enterprise(preview, keyCred), preview, keyCred, nil},
+		{"base script/key", Base(mainnet, scriptCred, keyCred), mainnet, scriptCred, &keyCred},
+		{"base key/script", Base(preview, keyCred, scriptCred), preview, keyCred, &scriptCred},
+	}
+	for _, tt := range tests {
+		networkID, payment, stake, err := Parse(tt.address)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if networkID != tt.network.ID {
+			t.Errorf("%s: network ID = %d, want %d", tt.name, networkID, tt.network.ID)
+		}
+		if !reflect.DeepEqual(payment, tt.payment) {
+			t.Errorf("%s: payment = %+v, want %+v", tt.name, payment, tt.payment)
+		}
+		if !reflect.DeepEqual(stake, tt.stake) {
+			t.Errorf("%s: stake = %+v, want %+v", tt.name, stake, tt.stake)
+		}
+	}
+
+	for _, s := range []string{
+		Reward(mainnet, keyCred),
+		"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wy",
+		"addr1W8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+	} {
+		if _, _, _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%s) succeeded, want an error", s)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package address
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// bech32Charset maps 5-bit groups to the characters of bech32 strings.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// encodeBech32 encodes data as a bech32 string with the human-readable part hrp, as
+// specified by BIP-173 but without its limit of 90 characters, which Cardano addresses
+// exceed.
+func encodeBech32(hrp string, data []byte) string {
+	values := toBase32(data)
+	checksum := bech32Checksum(hrp, values)
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range append(values, checksum...) {
+		b.WriteByte(bech32Charset[v])
+	}
+	return b.String()
+}
+
+// toBase32 splits data into 5-bit groups, padding the last one with zeros.
+func toBase32(data []byte) []byte {
+	var out []byte
+	acc, bits := 0, 0
+	for _, b := range data {
+		acc = acc<<8 | int(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out = append(out, byte(acc>>bits&31))
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(5-bits)&31))
+	}
+	return out
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if top>>i&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32Checksum(hrp string, values []byte) []byte {
+	var expanded []byte
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	expanded = append(expanded, values...)
+	expanded = append(expanded, 0, 0, 0, 0, 0, 0)
+	mod := bech32Polymod(expanded) ^ 1
+	checksum := make([]byte, 6)
+	for i := range checksum {
+		checksum[i] = byte(mod >> (5 * (5 - i)) & 31)
+	}
+	return checksum
+}
+
+// decodeBech32 decodes a bech32 string into its human-readable part and data, checking
+// its checksum. Like encodeBech32, it accepts strings longer than 90 characters.
+func decodeBech32(s string) (string, []byte, error) {
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("invalid bech32 string %q: mixed case", s)
+	}
+	s = strings.ToLower(s)
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, fmt.Errorf("invalid bech32 string %q: missing separator or checksum", s)
+	}
+	hrp := s[:sep]
+	values := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		v := strings.IndexByte(bech32Charset, s[i])
+		if v < 0 {
+			return "", nil, fmt.Errorf("invalid bech32 string %q: invalid character %q", s, s[i])
+		}
+		values = append(values, byte(v))
+	}
+	data, checksum := values[:len(values)-6], values[len(values)-6:]
+	if !bytes.Equal(bech32Checksum(hrp, data), checksum) {
+		return "", nil, fmt.Errorf("invalid bech32 string %q: wrong checksum", s)
+	}
+	decoded, err := fromBase32(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid bech32 string %q: %w", s, err)
+	}
+	return hrp, decoded, nil
+}
+
+// fromBase32 joins 5-bit groups into bytes, undoing toBase32.
+func fromBase32(values []byte) ([]byte, error) {
+	var out []byte
+	acc, bits := 0, 0
+	for _, v := range values {
+		acc = acc<<5 | int(v)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			out = append(out, byte(acc>>bits))
+		}
+	}
+	if bits >= 5 || acc&(1<<bits-1) != 0 {
+		return nil, fmt.Errorf("invalid padding")
+	}
+	return out, nil
+}
@@ -0,0 +1,187 @@
+// Package conformance checks that the encoders of Plutus data agree byte for byte. For
+// each example value of a blueprint definition it compares the CBOR produced by
+//
+//   - the Go types generated for the blueprint, built and run as a program,
+//   - the schema-aware encode package behind the CLI, serialized by the plutusdata
+//     package, and
+//   - the same Plutus data serialized by a reference encoder written from the ledger's
+//     conventions,
+//
+// and reports the first path where they diverge.
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/encode"
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/generator/golang"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Suite is a set of examples of the definitions of a blueprint, as read from JSON.
+type Suite struct {
+	// Blueprint is the path of the blueprint, relative to the suite file.
+	Blueprint string    `json:"blueprint"`
+	Examples  []Example `json:"examples"`
+}
+
+// Example is a value of a definition in the JSON read by the encode package.
+type Example struct {
+	Name string `json:"name"`
+	// Ref is the definition key, such as "market/Listing".
+	Ref   string          `json:"ref"`
+	Value json.RawMessage `json:"value"`
+	// CBOR optionally pins the expected encoding, in hex.
+	CBOR string `json:"cbor,omitempty"`
+}
+
+// LoadSuite reads a suite and the blueprint it refers to.
+func LoadSuite(path string) (*Suite, *parser.PlutusSchema, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var suite Suite
+	if err := json.Unmarshal(text, &suite); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	schema, err := parser.ParsePlutusJSON(filepath.Join(filepath.Dir(path), suite.Blueprint))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &suite, schema, nil
+}
+
+// Encoders names the encoders compared, in the order of Result.Encodings.
+var Encoders = []string{"generated", "encode", "reference"}
+
+// Result holds the encodings of an example by each of the Encoders.
+type Result struct {
+	Example   Example
+	Encodings [][]byte
+}
+
+// Divergence describes the first place where the encodings of r differ from each other or
+// from the pinned encoding of the example, or returns "" if they all agree.
+func (r Result) Divergence() string {
+	encodings, names := r.Encodings, Encoders
+	if r.Example.CBOR != "" {
+		pinned, err := hex.DecodeString(r.Example.CBOR)
+		if err != nil {
+			return fmt.Sprintf("invalid pinned CBOR: %v", err)
+		}
+		encodings = append([][]byte{pinned}, encodings...)
+		names = append([]string{"pinned"}, names...)
+	}
+	for i := 1; i < len(encodings); i++ {
+		if path, a, b := FirstDivergence(encodings[0], encodings[i]); path != "" {
+			return fmt.Sprintf("%s: %s has %s, %s has %s", path, names[0], abbreviate(a), names[i], abbreviate(b))
+		}
+	}
+	return ""
+}
+
+// abbreviate returns the hex of an encoded item, shortened if long.
+func abbreviate(b []byte) string {
+	s := hex.EncodeToString(b)
+	if len(s) > 64 {
+		return s[:64] + "..."
+	}
+	return s
+}
+
+// Run encodes the examples of suite with every encoder. The generated Go types are built
+// in a temporary module using the gogenesis checkout at module, which needs the go tool.
+func Run(suite *Suite, schema *parser.PlutusSchema, module string) ([]Result, error) {
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(suite.Examples))
+	results := make([]Result, len(suite.Examples))
+	for i, example := range suite.Examples {
+		value, err := encode.ParseJSON(example.Value)
+		if err != nil {
+			return nil, fmt.Errorf("example %s: %w", example.Name, err)
+		}
+		values[i] = value
+		d, err := encode.Encode(value, &ir.Ref{Key: example.Ref}, types)
+		if err != nil {
+			return nil, fmt.Errorf("example %s: %w", example.Name, err)
+		}
+		results[i] = Result{Example: example, Encodings: [][]byte{nil, plutusdata.Encode(d), referenceEncode(d)}}
+	}
+
+	generated, err := runGenerated(schema, suite.Examples, values, module)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Encodings[0] = generated[i]
+	}
+	return results, nil
+}
+
+// runGenerated generates the Go types of schema together with a program printing the
+// encoding of each example, and runs it.
+func runGenerated(schema *parser.PlutusSchema, examples []Example, values []interface{}, module string) ([][]byte, error) {
+	dir, err := os.MkdirTemp("", "gogenesis-conformance")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	opts := generator.GeneratorOptions{
+		Language:  "golang",
+		WellKnown: generator.DefaultWellKnownRegistry(),
+	}
+	for i, example := range examples {
+		opts.Examples = append(opts.Examples, generator.Example{Ref: example.Ref, Value: values[i]})
+	}
+	if err := generator.NewGeneratorWithOptions(dir, opts, golang.NewGoGenerator()).Generate(schema); err != nil {
+		return nil, err
+	}
+	goMod := fmt.Sprintf("module conformance\n\ngo 1.21\n\nrequire github.com/mgpai22/gogenesis v0.0.0\n\nreplace github.com/mgpai22/gogenesis => %s\n", module)
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("go", "run", "-mod=mod", ".")
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running the generated Go types: %v\n%s", err, stderr.String())
+	}
+
+	encodings := make([][]byte, len(examples))
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(nil, len(out)+1)
+	for scanner.Scan() {
+		index, encoded, _ := strings.Cut(scanner.Text(), "\t")
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 || i >= len(examples) {
+			return nil, fmt.Errorf("unexpected output of the generated Go types: %q", scanner.Text())
+		}
+		if msg, ok := strings.CutPrefix(encoded, "error: "); ok {
+			return nil, fmt.Errorf("example %s: generated Go types: %s", examples[i].Name, msg)
+		}
+		if encodings[i], err = hex.DecodeString(encoded); err != nil {
+			return nil, fmt.Errorf("example %s: generated Go types: %w", examples[i].Name, err)
+		}
+	}
+	return encodings, nil
+}
@@ -0,0 +1,75 @@
+package conformance
+
+import (
+	"encoding/hex"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated Go types")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go tool is not available")
+	}
+	module, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	suites, err := filepath.Glob("../../testdata/conformance/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range suites {
+		path := path
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			suite, schema, err := LoadSuite(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			results, err := Run(suite, schema, module)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, result := range results {
+				if d := result.Divergence(); d != "" {
+					t.Errorf("%s: %s", result.Example.Name, d)
+				}
+			}
+		})
+	}
+}
+
+func TestFirstDivergence(t *testing.T) {
+	tests := []struct {
+		a, b string
+		path string
+	}{
+		{"d87980", "d87980", ""},
+		{"d87980", "d87a80", "$"},
+		// Constructor fields, and list items within them.
+		{"d8799f0102ff", "d8799f0103ff", "$.1"},
+		{"d8799f9f01ffff", "d8799f8101ff", "$.0"},
+		{"d8799f9f0102ffff", "d8799f9f0103ffff", "$.0[1]"},
+		// A list of two-item lists against a map.
+		{"9f9f4101ff01ff", "a1410101", "$"},
+		{"a2410101410202", "a2410101410203", "$[1][1]"},
+		// The general constructor form.
+		{"d8668218809f01ff", "d8668218809f02ff", "$.0"},
+		{"d8668218809f01ff", "d8668218819f01ff", "$"},
+		// Indefinite lists agreeing on their common items.
+		{"9f0102ff", "9f01ff", "$"},
+	}
+	for _, tt := range tests {
+		a, _ := hex.DecodeString(tt.a)
+		b, _ := hex.DecodeString(tt.b)
+		if path, _, _ := FirstDivergence(a, b); path != tt.path {
+			t.Errorf("FirstDivergence(%s, %s) = %q, want %q", tt.a, tt.b, path, tt.path)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package conformance
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// FirstDivergence walks two CBOR encodings of Plutus data side by side and returns the
+// path of the first item where they differ, with that item as encoded in each, or an
+// empty path if they are identical. Paths name list items and map entries as [i], the
+// key and value of an entry as [i][0] and [i][1], and constructor fields as .i, so
+// $.1[0] is the first item of the list in the second field of a constructor.
+func FirstDivergence(a, b []byte) (string, []byte, []byte) {
+	x, _, errA := readItem(a)
+	y, _, errB := readItem(b)
+	if errA != nil || errB != nil {
+		if bytes.Equal(a, b) {
+			return "", nil, nil
+		}
+		return "$", a, b
+	}
+	return divergence(x, y, "$", false)
+}
+
+// cborItem is a decoded CBOR data item, keeping its raw encoding.
+type cborItem struct {
+	major byte
+	arg   uint64
+	head  []byte
+	raw   []byte
+	// items holds the items of an array or the keys and values of a map in turn, or the
+	// content of a tag.
+	items []cborItem
+}
+
+// divergence returns the first place where x and y differ. Constructor fields, the items
+// of the array tagged as a constructor, are named as .i when fields is set.
+func divergence(x, y cborItem, path string, fields bool) (string, []byte, []byte) {
+	if bytes.Equal(x.raw, y.raw) {
+		return "", nil, nil
+	}
+	if x.major != y.major || !bytes.Equal(x.head, y.head) || x.major < 4 {
+		return path, x.raw, y.raw
+	}
+	// Indefinite arrays and maps of different lengths differ as a whole, once their
+	// common items agree.
+	n := len(x.items)
+	if len(y.items) < n {
+		n = len(y.items)
+	}
+	switch x.major {
+	case 4:
+		for i := 0; i < n; i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if fields {
+				itemPath = fmt.Sprintf("%s.%d", path, i)
+			}
+			if p, a, b := divergence(x.items[i], y.items[i], itemPath, false); p != "" {
+				return p, a, b
+			}
+		}
+	case 5:
+		for i := 0; i < n; i++ {
+			if p, a, b := divergence(x.items[i], y.items[i], fmt.Sprintf("%s[%d][%d]", path, i/2, i%2), false); p != "" {
+				return p, a, b
+			}
+		}
+	case 6:
+		if x.arg == 2 || x.arg == 3 {
+			// Bignums differ as a whole.
+			return path, x.raw, y.raw
+		}
+		if x.arg == 102 {
+			// The general constructor form is the array [index, fields].
+			inner, innerY := x.items[0], y.items[0]
+			if len(inner.items) == 2 && len(innerY.items) == 2 && bytes.Equal(inner.items[0].raw, innerY.items[0].raw) {
+				return divergence(inner.items[1], innerY.items[1], path, true)
+			}
+			return path, x.raw, y.raw
+		}
+		constr := (x.arg >= 121 && x.arg <= 127) || (x.arg >= 1280 && x.arg <= 1400)
+		return divergence(x.items[0], y.items[0], path, constr)
+	}
+	return path, x.raw, y.raw
+}
+
+// readItem decodes the CBOR item at the start of buf and returns it with the rest of buf.
+// Indefinite byte strings are read as a whole, their chunks not being items of their own.
+func readItem(buf []byte) (cborItem, []byte, error) {
+	if len(buf) == 0 {
+		return cborItem{}, nil, fmt.Errorf("unexpected end of input")
+	}
+	major, info := buf[0]>>5, buf[0]&0x1f
+	var arg uint64
+	size := 1
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= 27:
+		size += 1 << (info - 24)
+		if len(buf) < size {
+			return cborItem{}, nil, fmt.Errorf("truncated head")
+		}
+		for _, c := range buf[1:size] {
+			arg = arg<<8 | uint64(c)
+		}
+	case info == 31 && (major == 2 || major == 4 || major == 5):
+	default:
+		return cborItem{}, nil, fmt.Errorf("unsupported head %02x", buf[0])
+	}
+	item := cborItem{major: major, arg: arg, head: buf[:size]}
+	rest := buf[size:]
+	indefinite := info == 31
+
+	var count uint64
+	switch major {
+	case 0, 1:
+	case 2, 3:
+		if indefinite {
+			for len(rest) > 0 && rest[0] != 0xff {
+				var err error
+				if _, rest, err = readItem(rest); err != nil {
+					return cborItem{}, nil, err
+				}
+			}
+			if len(rest) == 0 {
+				return cborItem{}, nil, fmt.Errorf("unterminated byte string")
+			}
+			rest = rest[1:]
+		} else {
+			if uint64(len(rest)) < arg {
+				return cborItem{}, nil, fmt.Errorf("truncated byte string")
+			}
+			rest = rest[arg:]
+		}
+	case 4:
+		count = arg
+	case 5:
+		count = 2 * arg
+	case 6:
+		count = 1
+	default:
+		return cborItem{}, nil, fmt.Errorf("unsupported major type %d", major)
+	}
+	if major >= 4 {
+		for i := uint64(0); indefinite || i < count; i++ {
+			if indefinite && len(rest) > 0 && rest[0] == 0xff {
+				rest = rest[1:]
+				break
+			}
+			child, next, err := readItem(rest)
+			if err != nil {
+				return cborItem{}, nil, err
+			}
+			item.items = append(item.items, child)
+			rest = next
+		}
+	}
+	item.raw = buf[:len(buf)-len(rest)]
+	return item, rest, nil
+}
@@ -0,0 +1,106 @@
+package conformance
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// referenceEncode serializes Plutus data the way the Cardano node does, written
+// independently of the plutusdata package so that the two can be checked against each
+// other:
+//
+//   - constructors 0-6 are tagged 121-127, 7-127 are tagged 1280-1400, and others are
+//     tagged 102 around the array [index, fields];
+//   - empty lists are the definite array 80, others are indefinite arrays;
+//   - maps are definite;
+//   - byte strings longer than 64 bytes are indefinite strings of 64-byte chunks;
+//   - integers outside 64 bits are tagged 2 or 3 bignums, whose bytes are chunked like
+//     any byte string.
+func referenceEncode(d plutusdata.Data) []byte {
+	switch v := d.(type) {
+	case plutusdata.Constr:
+		var out []byte
+		switch {
+		case v.Index < 7:
+			out = referenceHead(6, 121+v.Index)
+		case v.Index < 128:
+			out = referenceHead(6, 1280+v.Index-7)
+		default:
+			out = append(referenceHead(6, 102), referenceHead(4, 2)...)
+			out = append(out, referenceHead(0, v.Index)...)
+		}
+		return append(out, referenceList(v.Fields)...)
+	case plutusdata.List:
+		return referenceList(v)
+	case plutusdata.Map:
+		out := referenceHead(5, uint64(len(v)))
+		for _, pair := range v {
+			out = append(out, referenceEncode(pair.Key)...)
+			out = append(out, referenceEncode(pair.Value)...)
+		}
+		return out
+	case plutusdata.Integer:
+		n := v.Value
+		if n.Sign() >= 0 {
+			if n.IsUint64() {
+				return referenceHead(0, n.Uint64())
+			}
+			return append(referenceHead(6, 2), referenceBytes(n.Bytes())...)
+		}
+		// Negative integers n are encoded as -1 - n.
+		m := new(big.Int).Sub(new(big.Int).Neg(n), big.NewInt(1))
+		if m.IsUint64() {
+			return referenceHead(1, m.Uint64())
+		}
+		return append(referenceHead(6, 3), referenceBytes(m.Bytes())...)
+	case plutusdata.Bytes:
+		return referenceBytes(v)
+	}
+	panic("unknown Plutus data")
+}
+
+func referenceList(items []plutusdata.Data) []byte {
+	if len(items) == 0 {
+		return []byte{0x80}
+	}
+	out := []byte{0x9f}
+	for _, item := range items {
+		out = append(out, referenceEncode(item)...)
+	}
+	return append(out, 0xff)
+}
+
+func referenceBytes(b []byte) []byte {
+	if len(b) <= 64 {
+		return append(referenceHead(2, uint64(len(b))), b...)
+	}
+	out := []byte{0x5f}
+	for start := 0; start < len(b); start += 64 {
+		end := start + 64
+		if end > len(b) {
+			end = len(b)
+		}
+		out = append(out, referenceHead(2, uint64(end-start))...)
+		out = append(out, b[start:end]...)
+	}
+	return append(out, 0xff)
+}
+
+// referenceHead returns the shortest head of an item of the major type with argument n.
+func referenceHead(major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return []byte{m | byte(n)}
+	case n < 1<<8:
+		return []byte{m | 24, byte(n)}
+	case n < 1<<16:
+		return binary.BigEndian.AppendUint16([]byte{m | 25}, uint16(n))
+	case n < 1<<32:
+		return binary.BigEndian.AppendUint32([]byte{m | 26}, uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64([]byte{m | 27}, n)
+	}
+}
@@ -0,0 +1,496 @@
+// Package encode converts JSON values to Plutus data following the schemas of a
+// blueprint, validating them on the way.
+//
+// Values are written as follows:
+//
+//   - integer: a JSON number, or a string of decimal digits for large values
+//   - bytes: a hex string
+//   - #string: a string, encoded as its UTF-8 bytes
+//   - #boolean: true or false; #unit: null
+//   - list, #list and tuples: an array
+//   - map, and lists of #pair which are encoded as a map: an array of [key, value] arrays
+//   - #pair: a [left, right] array
+//   - constructor: the constructor title as a string if it has no fields, otherwise an
+//     object with the constructor title as its only key, holding an object of fields
+//     keyed by title, or an array of the fields if they are untitled. Records, having a
+//     single constructor, are written as their fields alone.
+//   - Data, without a schema: the detailed JSON of cardano-cli, such as {"int": 1} or
+//     {"constructor": 0, "fields": [...]}
+package encode
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/plutusdata"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// ParseJSON decodes JSON text into a value for Encode, keeping numbers exact.
+func ParseJSON(text []byte) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(string(text)))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// Encode converts value, as decoded by ParseJSON, to Plutus data of type t, whose
+// references point to the definitions of types. Errors name the path of the offending
+// value, such as $.owner[2].
+func Encode(value interface{}, t ir.Type, types *ir.Schema) (plutusdata.Data, error) {
+	e := &encoder{types: types}
+	return e.encode("$", value, t, 0)
+}
+
+// Constant converts value, as decoded by ParseJSON, to the constant a validator
+// parameter of type t is applied as (see uplc.Script.ApplyConstants). Parameters of a
+// builtin primitive type, such as #integer, are constants of that type; all others are
+// Plutus data, as converted by Encode.
+func Constant(value interface{}, t ir.Type, types *ir.Schema) (uplc.Constant, error) {
+	for depth := 0; depth < maxDepth; depth++ {
+		ref, ok := t.(*ir.Ref)
+		if !ok {
+			break
+		}
+		def, ok := types.Definitions[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("$: unknown definition %s", ref.Key)
+		}
+		t = def.Type
+	}
+	p, ok := t.(*ir.Primitive)
+	if !ok || !p.Builtin {
+		d, err := Encode(value, t, types)
+		if err != nil {
+			return nil, err
+		}
+		return uplc.Data{Value: d}, nil
+	}
+	// Check the value as if it were data, then take it back as a constant.
+	d, err := primitive("$", value, p.Kind)
+	if err != nil {
+		return nil, err
+	}
+	switch p.Kind {
+	case ir.Integer:
+		return uplc.Integer{Value: d.(plutusdata.Integer).Value}, nil
+	case ir.Bytes:
+		return uplc.ByteString(d.(plutusdata.Bytes)), nil
+	case ir.String:
+		return uplc.String(value.(string)), nil
+	case ir.Boolean:
+		return uplc.Bool(value.(bool)), nil
+	default:
+		return uplc.Unit{}, nil
+	}
+}
+
+// maxDepth bounds the nesting of encoded values, which recursive schemas leave unbounded.
+const maxDepth = 1000
+
+type encoder struct {
+	types *ir.Schema
+}
+
+func (e *encoder) encode(path string, value interface{}, t ir.Type, depth int) (plutusdata.Data, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("%s: value nested deeper than %d levels", path, maxDepth)
+	}
+	depth++
+	switch t := t.(type) {
+	case *ir.Ref:
+		def, ok := e.types.Definitions[t.Key]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown definition %s", path, t.Key)
+		}
+		return e.encode(path, value, def.Type, depth)
+	case *ir.Sum, *ir.Product:
+		return e.constructor(path, value, ir.Constructors(t), depth)
+	case *ir.Primitive:
+		return primitive(path, value, t.Kind)
+	case *ir.List:
+		return e.list(path, value, t, depth)
+	case *ir.Tuple:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expected an array, found %s", path, describe(value))
+		}
+		if len(items) != len(t.Items) {
+			return nil, fmt.Errorf("%s: expected a tuple of %d items, found %d", path, len(t.Items), len(items))
+		}
+		out := plutusdata.List{}
+		for i, item := range items {
+			d, err := e.encode(fmt.Sprintf("%s[%d]", path, i), item, t.Items[i], depth)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, d)
+		}
+		return out, nil
+	case *ir.Map:
+		return e.dataMap(path, value, t, depth)
+	case *ir.Pair:
+		items, ok := value.([]interface{})
+		if !ok || len(items) != 2 {
+			return nil, fmt.Errorf("%s: expected a [left, right] array, found %s", path, describe(value))
+		}
+		left, err := e.encode(path+"[0]", items[0], t.Left, depth)
+		if err != nil {
+			return nil, err
+		}
+		right, err := e.encode(path+"[1]", items[1], t.Right, depth)
+		if err != nil {
+			return nil, err
+		}
+		return plutusdata.List{left, right}, nil
+	default:
+		return detailed(path, value, depth)
+	}
+}
+
+// primitive encodes a value of a primitive type.
+func primitive(path string, value interface{}, kind ir.Kind) (plutusdata.Data, error) {
+	switch kind {
+	case ir.Integer:
+		return integer(path, value)
+	case ir.Bytes:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a hex string, found %s", path, describe(value))
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid hex string: %w", path, err)
+		}
+		return plutusdata.Bytes(b), nil
+	case ir.String:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a string, found %s", path, describe(value))
+		}
+		return plutusdata.Bytes(s), nil
+	case ir.Boolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a boolean, found %s", path, describe(value))
+		}
+		if b {
+			return plutusdata.Constr{Index: 1, Fields: []plutusdata.Data{}}, nil
+		}
+		return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{}}, nil
+	default:
+		if value != nil {
+			return nil, fmt.Errorf("%s: expected null, found %s", path, describe(value))
+		}
+		return plutusdata.Constr{Index: 0, Fields: []plutusdata.Data{}}, nil
+	}
+}
+
+func (e *encoder) constructor(path string, value interface{}, constructors []*ir.Constructor, depth int) (plutusdata.Data, error) {
+	var cons *ir.Constructor
+	var fields interface{}
+	titles := make([]string, len(constructors))
+	for i, c := range constructors {
+		titles[i] = c.Title
+	}
+	find := func(title string) bool {
+		for _, c := range constructors {
+			if c.Title == title {
+				cons = c
+				return true
+			}
+		}
+		return false
+	}
+
+	switch v := value.(type) {
+	case bool:
+		// Bool is declared in the prelude as False | True.
+		if len(constructors) != 2 || titles[0] != "False" || titles[1] != "True" {
+			return nil, fmt.Errorf("%s: expected one of the constructors %s, found a boolean", path, strings.Join(titles, ", "))
+		}
+		if v {
+			find("True")
+		} else {
+			find("False")
+		}
+	case string:
+		if !find(v) {
+			return nil, fmt.Errorf("%s: unknown constructor %q, expected one of %s", path, v, strings.Join(titles, ", "))
+		}
+		if len(cons.Fields) > 0 {
+			return nil, fmt.Errorf("%s: constructor %s takes %d fields", path, v, len(cons.Fields))
+		}
+	default:
+		if len(constructors) == 1 {
+			cons, fields = constructors[0], value
+			break
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok || len(object) != 1 {
+			return nil, fmt.Errorf("%s: expected a constructor of %s, found %s", path, strings.Join(titles, ", "), describe(value))
+		}
+		for title, f := range object {
+			if !find(title) {
+				return nil, fmt.Errorf("%s: unknown constructor %q, expected one of %s", path, title, strings.Join(titles, ", "))
+			}
+			fields = f
+			path += "." + title
+		}
+	}
+
+	out := plutusdata.Constr{Index: uint64(cons.Index), Fields: []plutusdata.Data{}}
+	if fields == nil && len(cons.Fields) == 0 {
+		return out, nil
+	}
+	switch f := fields.(type) {
+	case []interface{}:
+		if len(f) != len(cons.Fields) {
+			return nil, fmt.Errorf("%s: constructor %s takes %d fields, found %d", path, cons.Title, len(cons.Fields), len(f))
+		}
+		for i, field := range cons.Fields {
+			d, err := e.encode(fmt.Sprintf("%s[%d]", path, i), f[i], field.Type, depth)
+			if err != nil {
+				return nil, err
+			}
+			out.Fields = append(out.Fields, d)
+		}
+	case map[string]interface{}:
+		known := make(map[string]bool, len(cons.Fields))
+		for i, field := range cons.Fields {
+			if field.Title == "" {
+				return nil, fmt.Errorf("%s: field %d of constructor %s is untitled, write the fields as an array", path, i, cons.Title)
+			}
+			known[field.Title] = true
+			v, ok := f[field.Title]
+			if !ok {
+				return nil, fmt.Errorf("%s: missing field %s", path, field.Title)
+			}
+			d, err := e.encode(path+"."+field.Title, v, field.Type, depth)
+			if err != nil {
+				return nil, err
+			}
+			out.Fields = append(out.Fields, d)
+		}
+		var unknown []string
+		for title := range f {
+			if !known[title] {
+				unknown = append(unknown, title)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return nil, fmt.Errorf("%s: unknown fields %s of constructor %s", path, strings.Join(unknown, ", "), cons.Title)
+		}
+	default:
+		return nil, fmt.Errorf("%s: expected the fields of constructor %s as an object or array, found %s", path, cons.Title, describe(fields))
+	}
+	return out, nil
+}
+
+func (e *encoder) list(path string, value interface{}, t *ir.List, depth int) (plutusdata.Data, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an array, found %s", path, describe(value))
+	}
+	out := plutusdata.List{}
+	for i, item := range items {
+		d, err := e.encode(fmt.Sprintf("%s[%d]", path, i), item, t.Items, depth)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	if err := checkItems(path, t.MinItems, t.MaxItems, len(out), t.UniqueItems, func(i, j int) bool { return plutusdata.Equal(out[i], out[j]) }); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// dataMap encodes an array of [key, value] arrays as a map. Lists of pairs, such as
+// Aiken's Pairs, are encoded the same way, but may repeat a key.
+func (e *encoder) dataMap(path string, value interface{}, t *ir.Map, depth int) (plutusdata.Data, error) {
+	entries, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an array of [key, value] arrays, found %s", path, describe(value))
+	}
+	out := plutusdata.Map{}
+	for i, entry := range entries {
+		pair, ok := entry.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("%s[%d]: expected a [key, value] array, found %s", path, i, describe(entry))
+		}
+		key, err := e.encode(fmt.Sprintf("%s[%d][0]", path, i), pair[0], t.Keys, depth)
+		if err != nil {
+			return nil, err
+		}
+		v, err := e.encode(fmt.Sprintf("%s[%d][1]", path, i), pair[1], t.Values, depth)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, plutusdata.Pair{Key: key, Value: v})
+	}
+	if err := checkItems(path, t.MinItems, t.MaxItems, len(out), t.UniqueKeys, func(i, j int) bool { return plutusdata.Equal(out[i].Key, out[j].Key) }); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// checkItems checks that the n items of a list or map are within minItems and maxItems,
+// the latter being unbounded if zero, and that no two items are equal if unique is set.
+func checkItems(path string, minItems, maxItems, n int, unique bool, equal func(i, j int) bool) error {
+	if n < minItems {
+		return fmt.Errorf("%s: expected at least %d items, found %d", path, minItems, n)
+	}
+	if maxItems > 0 && n > maxItems {
+		return fmt.Errorf("%s: expected at most %d items, found %d", path, maxItems, n)
+	}
+	if unique {
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				if equal(i, j) {
+					return fmt.Errorf("%s[%d]: duplicate of item %d", path, i, j)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func integer(path string, value interface{}) (plutusdata.Data, error) {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return nil, fmt.Errorf("%s: expected an integer, found %s", path, describe(value))
+	}
+	n, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid integer %q", path, text)
+	}
+	return plutusdata.Integer{Value: n}, nil
+}
+
+// detailed decodes Data written in the detailed JSON schema of cardano-cli.
+func detailed(path string, value interface{}, depth int) (plutusdata.Data, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("%s: value nested deeper than %d levels", path, maxDepth)
+	}
+	depth++
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected Data as an object such as {\"int\": 1}, found %s", path, describe(value))
+	}
+	switch {
+	case len(object) == 1 && object["int"] != nil:
+		return integer(path+".int", object["int"])
+	case len(object) == 1 && object["bytes"] != nil:
+		s, ok := object["bytes"].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.bytes: expected a hex string", path)
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s.bytes: invalid hex string: %w", path, err)
+		}
+		return plutusdata.Bytes(b), nil
+	case len(object) == 1 && object["list"] != nil:
+		items, ok := object["list"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.list: expected an array", path)
+		}
+		out := plutusdata.List{}
+		for i, item := range items {
+			d, err := detailed(fmt.Sprintf("%s.list[%d]", path, i), item, depth)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, d)
+		}
+		return out, nil
+	case len(object) == 1 && object["map"] != nil:
+		entries, ok := object["map"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.map: expected an array", path)
+		}
+		out := plutusdata.Map{}
+		for i, entry := range entries {
+			kv, ok := entry.(map[string]interface{})
+			if !ok || len(kv) != 2 || kv["k"] == nil || kv["v"] == nil {
+				return nil, fmt.Errorf("%s.map[%d]: expected an object with k and v", path, i)
+			}
+			k, err := detailed(fmt.Sprintf("%s.map[%d].k", path, i), kv["k"], depth)
+			if err != nil {
+				return nil, err
+			}
+			v, err := detailed(fmt.Sprintf("%s.map[%d].v", path, i), kv["v"], depth)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, plutusdata.Pair{Key: k, Value: v})
+		}
+		return out, nil
+	case len(object) == 2 && object["constructor"] != nil && object["fields"] != nil:
+		index, err := integer(path+".constructor", object["constructor"])
+		if err != nil {
+			return nil, err
+		}
+		n := index.(plutusdata.Integer).Value
+		if n.Sign() < 0 || !n.IsUint64() {
+			return nil, fmt.Errorf("%s.constructor: invalid constructor index %s", path, n)
+		}
+		fields, ok := object["fields"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.fields: expected an array", path)
+		}
+		out := plutusdata.Constr{Index: n.Uint64(), Fields: []plutusdata.Data{}}
+		for i, field := range fields {
+			d, err := detailed(fmt.Sprintf("%s.fields[%d]", path, i), field, depth)
+			if err != nil {
+				return nil, err
+			}
+			out.Fields = append(out.Fields, d)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("%s: expected Data as one of {\"int\"}, {\"bytes\"}, {\"list\"}, {\"map\"} or {\"constructor\", \"fields\"}", path)
+	}
+}
+
+// describe names the JSON type of value for error messages.
+func describe(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	case string:
+		if utf8.RuneCountInString(v) > 20 {
+			return "a string"
+		}
+		return fmt.Sprintf("the string %q", v)
+	case []interface{}:
+		return fmt.Sprintf("an array of %d items", len(v))
+	case map[string]interface{}:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,163 @@
+package encode
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/plutusdata"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+const testDefinitions = `{
+	"Int": {"dataType": "integer"},
+	"ByteArray": {"dataType": "bytes"},
+	"String": {"dataType": "#string"},
+	"Count": {"dataType": "#integer"},
+	"Flag": {"dataType": "#boolean"},
+	"Bool": {"anyOf": [
+		{"title": "False", "dataType": "constructor", "index": 0, "fields": []},
+		{"title": "True", "dataType": "constructor", "index": 1, "fields": []}
+	]},
+	"Action": {"anyOf": [
+		{"title": "Buy", "dataType": "constructor", "index": 0, "fields": []},
+		{"title": "Sell", "dataType": "constructor", "index": 1, "fields": [
+			{"title": "price", "$ref": "#/definitions/Int"},
+			{"title": "seller", "$ref": "#/definitions/ByteArray"}
+		]}
+	]},
+	"Point": {"anyOf": [
+		{"title": "Point", "dataType": "constructor", "index": 0, "fields": [
+			{"$ref": "#/definitions/Int"},
+			{"$ref": "#/definitions/Int"}
+		]}
+	]},
+	"Tags": {"dataType": "list", "items": {"$ref": "#/definitions/ByteArray"}, "uniqueItems": true, "maxItems": 2},
+	"Prices": {"dataType": "map", "keys": {"$ref": "#/definitions/ByteArray"}, "values": {"$ref": "#/definitions/Int"}},
+	"Fees": {"dataType": "list", "items": {"dataType": "#pair", "left": {"$ref": "#/definitions/ByteArray"}, "right": {"$ref": "#/definitions/Int"}}},
+	"Data": {}
+}`
+
+func testSchema(t *testing.T) *ir.Schema {
+	t.Helper()
+	var defs map[string]parser.PlutusDefinition
+	if err := json.Unmarshal([]byte(testDefinitions), &defs); err != nil {
+		t.Fatal(err)
+	}
+	types, err := ir.Build(defs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types
+}
+
+func TestEncode(t *testing.T) {
+	types := testSchema(t)
+	tests := []struct {
+		ref   string
+		value string
+		cbor  string
+	}{
+		{"Int", `42`, "182a"},
+		{"Int", `"-18446744073709551617"`, "c349010000000000000000"},
+		{"ByteArray", `"abcd"`, "42abcd"},
+		{"String", `"BTC"`, "43425443"},
+		{"Bool", `true`, "d87a80"},
+		{"Bool", `"False"`, "d87980"},
+		{"Action", `"Buy"`, "d87980"},
+		{"Action", `{"Sell": {"price": 5, "seller": "ff"}}`, "d87a9f0541ffff"},
+		{"Action", `{"Sell": [5, "ff"]}`, "d87a9f0541ffff"},
+		{"Point", `[1, 2]`, "d8799f0102ff"},
+		{"Tags", `[]`, "80"},
+		{"Tags", `["01", "02"]`, "9f41014102ff"},
+		{"Prices", `[["01", 1]]`, "a1410101"},
+		{"Fees", `[["01", 1]]`, "a1410101"},
+		{"Fees", `[["01", 1], ["01", 2]]`, "a2410101410102"},
+		{"Data", `{"constructor": 1, "fields": [{"int": 1}, {"list": [{"bytes": "00"}]}]}`, "d87a9f019f4100ffff"},
+	}
+	for _, tt := range tests {
+		value, err := ParseJSON([]byte(tt.value))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.value, err)
+		}
+		d, err := Encode(value, &ir.Ref{Key: tt.ref}, types)
+		if err != nil {
+			t.Errorf("Encode(%s as %s): %v", tt.value, tt.ref, err)
+			continue
+		}
+		if got := hex.EncodeToString(plutusdata.Encode(d)); got != tt.cbor {
+			t.Errorf("Encode(%s as %s) = %s, want %s", tt.value, tt.ref, got, tt.cbor)
+		}
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	types := testSchema(t)
+	tests := []struct {
+		ref   string
+		value string
+		err   string
+	}{
+		{"Int", `1.5`, `$: invalid integer "1.5"`},
+		{"ByteArray", `"zz"`, "$: invalid hex string"},
+		{"Action", `"Sell"`, "$: constructor Sell takes 2 fields"},
+		{"Action", `{"Swap": []}`, `$: unknown constructor "Swap"`},
+		{"Action", `{"Sell": {"price": 5}}`, "$.Sell: missing field seller"},
+		{"Action", `{"Sell": {"price": "x", "seller": ""}}`, `$.Sell.price: invalid integer "x"`},
+		{"Point", `{"x": 1}`, "field 0 of constructor Point is untitled"},
+		{"Tags", `["01", "01"]`, "$[1]: duplicate of item 0"},
+		{"Tags", `["01", "02", "03"]`, "$: expected at most 2 items, found 3"},
+		{"Prices", `[["01", 1], ["01", 2]]`, "$[1]: duplicate of item 0"},
+		{"Prices", `[["01", true]]`, "$[0][1]: expected an integer, found a boolean"},
+		{"Data", `{"int": 1, "bytes": ""}`, "$: expected Data as one of"},
+	}
+	for _, tt := range tests {
+		value, err := ParseJSON([]byte(tt.value))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.value, err)
+		}
+		_, err = Encode(value, &ir.Ref{Key: tt.ref}, types)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Encode(%s as %s) error = %v, want %q", tt.value, tt.ref, err, tt.err)
+		}
+	}
+}
+
+func TestConstant(t *testing.T) {
+	types := testSchema(t)
+	tests := []struct {
+		ref   string
+		value string
+		want  uplc.Constant
+	}{
+		// Builtin types are applied as constants of their own type.
+		{"Count", `42`, uplc.Integer{Value: big.NewInt(42)}},
+		{"String", `"BTC"`, uplc.String("BTC")},
+		{"Flag", `true`, uplc.Bool(true)},
+		// Other types are applied as data.
+		{"Int", `42`, uplc.Data{Value: plutusdata.NewInteger(42)}},
+		{"Bool", `true`, uplc.Data{Value: plutusdata.Constr{Index: 1, Fields: []plutusdata.Data{}}}},
+	}
+	for _, tt := range tests {
+		value, err := ParseJSON([]byte(tt.value))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.value, err)
+		}
+		got, err := Constant(value, &ir.Ref{Key: tt.ref}, types)
+		if err != nil {
+			t.Errorf("Constant(%s as %s): %v", tt.value, tt.ref, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Constant(%s as %s) = %#v, want %#v", tt.value, tt.ref, got, tt.want)
+		}
+	}
+	if _, err := Constant("x", &ir.Ref{Key: "Count"}, types); err == nil {
+		t.Error("Constant accepted a string for an #integer")
+	}
+}
@@ -0,0 +1,254 @@
+// Package evaluate runs the validators of a blueprint against datums, redeemers and
+// script contexts given as JSON.
+package evaluate
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/encode"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/plutusdata"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// Inputs are the arguments a validator is run against, as JSON text. Absent arguments
+// are nil.
+type Inputs struct {
+	// Params is a JSON array of parameters, following the parameter schemas.
+	Params []byte
+	// Datum and Redeemer follow the schemas of the validator, as in package encode.
+	Datum    []byte
+	Redeemer []byte
+	// Context is a whole script context, in the detailed JSON of cardano-cli. Without
+	// one, a mock context is built.
+	Context []byte
+	// TxInfo replaces the empty transaction of the mock context, in detailed JSON.
+	TxInfo []byte
+	// OutRef is the output a mock context for a spending validator spends, written as
+	// txid#index. It defaults to output 0 of an all-zero transaction ID.
+	OutRef string
+}
+
+// Outcome is the outcome of running a validator.
+type Outcome struct {
+	*uplc.EvalResult
+	// Err is why the validator failed, or nil if it succeeded.
+	Err error
+}
+
+// Run applies validator v of schema to inputs and evaluates it within limit. The error
+// reports invalid inputs; a failing validator is reported by the outcome. Only Plutus V3
+// validators can be run, as the evaluator implements the V3 cost model and builtins, and
+// validators using a builtin it lacks are rejected before they run; see
+// uplc.CheckBuiltins.
+func Run(schema *parser.PlutusSchema, v parser.PlutusValidator, inputs Inputs, limit uplc.Budget) (*Outcome, error) {
+	version := schema.PlutusVersion()
+	if version != parser.PlutusV3 {
+		return nil, fmt.Errorf("cannot run Plutus %s validator %s: only Plutus v3 validators are supported", version, v.Title)
+	}
+	script, err := uplc.ParseScript(v.CompiledCode, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := uplc.CheckBuiltins(script.Program); err != nil {
+		return nil, fmt.Errorf("cannot run validator %s: %w", v.Title, err)
+	}
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		return nil, err
+	}
+	if inputs.Params != nil {
+		params, err := encodeParams(v, inputs.Params, types)
+		if err != nil {
+			return nil, err
+		}
+		script = script.ApplyConstants(params...)
+	}
+
+	var datum, redeemer plutusdata.Data
+	if inputs.Datum != nil {
+		if v.Datum == nil {
+			return nil, fmt.Errorf("validator %s takes no datum", v.Title)
+		}
+		if datum, err = encodeJSON("datum", inputs.Datum, types.Convert(v.Datum.Schema), types); err != nil {
+			return nil, err
+		}
+	}
+	if inputs.Redeemer != nil {
+		var redeemerType ir.Type = &ir.Opaque{}
+		if v.Redeemer != nil {
+			redeemerType = types.Convert(v.Redeemer.Schema)
+		}
+		if redeemer, err = encodeJSON("redeemer", inputs.Redeemer, redeemerType, types); err != nil {
+			return nil, err
+		}
+	}
+
+	var ctx plutusdata.Data
+	if inputs.Context != nil {
+		if datum != nil || redeemer != nil {
+			return nil, errors.New("the datum and redeemer of Plutus V3 validators are part of the given script context")
+		}
+		if ctx, err = encodeJSON("context", inputs.Context, &ir.Opaque{}, nil); err != nil {
+			return nil, err
+		}
+	} else {
+		if redeemer == nil {
+			return nil, errors.New("a redeemer is required")
+		}
+		if ctx, err = mockContext(v, script, datum, redeemer, inputs); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := uplc.Eval(script.ApplyParams(ctx).Program, version, limit)
+	outcome := &Outcome{EvalResult: result, Err: err}
+	if err == nil && !isUnit(result.Term) {
+		outcome.Err = errors.New("validator did not return unit")
+	}
+	return outcome, nil
+}
+
+func isUnit(t uplc.Term) bool {
+	c, ok := t.(uplc.Const)
+	if !ok {
+		return false
+	}
+	_, ok = c.Value.(uplc.Unit)
+	return ok
+}
+
+func encodeJSON(what string, text []byte, t ir.Type, types *ir.Schema) (plutusdata.Data, error) {
+	value, err := encode.ParseJSON(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s JSON: %w", what, err)
+	}
+	d, err := encode.Encode(value, t, types)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", what, err)
+	}
+	return d, nil
+}
+
+func encodeParams(v parser.PlutusValidator, text []byte, types *ir.Schema) ([]uplc.Constant, error) {
+	value, err := encode.ParseJSON(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters JSON: %w", err)
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("parameters must be a JSON array")
+	}
+	if len(values) != len(v.Parameters) {
+		return nil, fmt.Errorf("validator %s takes %d parameters, got %d", v.Title, len(v.Parameters), len(values))
+	}
+	params := make([]uplc.Constant, len(values))
+	for i, value := range values {
+		if params[i], err = encode.Constant(value, types.Convert(v.Parameters[i].Schema), types); err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+	}
+	return params, nil
+}
+
+// mockContext builds a Plutus V3 script context for running v: a transaction, empty
+// unless inputs.TxInfo is given, and the script info of the validator's purpose.
+func mockContext(v parser.PlutusValidator, script *uplc.Script, datum, redeemer plutusdata.Data, inputs Inputs) (plutusdata.Data, error) {
+	if datum != nil && v.Purpose() != "spend" {
+		return nil, errors.New("only spending validators take a datum")
+	}
+	txInfo := emptyTxInfo()
+	if inputs.TxInfo != nil {
+		var err error
+		if txInfo, err = encodeJSON("transaction", inputs.TxInfo, &ir.Opaque{}, nil); err != nil {
+			return nil, err
+		}
+	}
+	scriptHash, err := script.Hash()
+	if err != nil {
+		return nil, err
+	}
+	hash := plutusdata.Bytes(scriptHash)
+
+	var info plutusdata.Data
+	switch purpose := v.Purpose(); purpose {
+	case "mint":
+		info = constr(0, hash)
+	case "spend":
+		outRef, err := parseOutRef(inputs.OutRef)
+		if err != nil {
+			return nil, err
+		}
+		maybeDatum := constr(1)
+		if datum != nil {
+			maybeDatum = constr(0, datum)
+		}
+		info = constr(1, outRef, maybeDatum)
+	case "withdraw":
+		info = constr(2, constr(1, hash))
+	default:
+		if purpose == "" {
+			purpose = "of " + v.Title
+		}
+		return nil, fmt.Errorf("cannot mock a script context for purpose %s, give one instead", purpose)
+	}
+	return constr(0, txInfo, redeemer, info), nil
+}
+
+// emptyTxInfo is a Plutus V3 transaction with no inputs, outputs or anything else, and
+// an unbounded validity range.
+func emptyTxInfo() plutusdata.Data {
+	empty := plutusdata.List{}
+	emptyMap := plutusdata.Map{}
+	always := constr(0,
+		constr(0, constr(0), constr(1)), // from negative infinity, inclusive
+		constr(0, constr(2), constr(1)), // to positive infinity, inclusive
+	)
+	return constr(0,
+		empty,                              // inputs
+		empty,                              // reference inputs
+		empty,                              // outputs
+		plutusdata.NewInteger(0),           // fee
+		emptyMap,                           // mint
+		empty,                              // certificates
+		emptyMap,                           // withdrawals
+		always,                             // validity range
+		empty,                              // signatories
+		emptyMap,                           // redeemers
+		emptyMap,                           // datums
+		plutusdata.Bytes(make([]byte, 32)), // id
+		emptyMap,                           // votes
+		empty,                              // proposal procedures
+		constr(1),                          // current treasury amount
+		constr(1),                          // treasury donation
+	)
+}
+
+// parseOutRef parses an output reference written as txid#index.
+func parseOutRef(s string) (plutusdata.Data, error) {
+	if s == "" {
+		return constr(0, plutusdata.Bytes(make([]byte, 32)), plutusdata.NewInteger(0)), nil
+	}
+	id, index, ok := strings.Cut(s, "#")
+	txID, err := hex.DecodeString(id)
+	if !ok || err != nil || len(txID) != 32 {
+		return nil, fmt.Errorf("output reference %s is not a 32-byte transaction ID in hex, a # and an index", s)
+	}
+	n, err := strconv.ParseInt(index, 10, 64)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid output index %s", index)
+	}
+	return constr(0, plutusdata.Bytes(txID), plutusdata.NewInteger(n)), nil
+}
+
+func constr(index uint64, fields ...plutusdata.Data) plutusdata.Data {
+	if fields == nil {
+		fields = []plutusdata.Data{}
+	}
+	return plutusdata.Constr{Index: index, Fields: fields}
+}
@@ -0,0 +1,159 @@
+package evaluate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/encode"
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/plutusdata"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+func validator(t *testing.T, schema *parser.PlutusSchema, title string) parser.PlutusValidator {
+	t.Helper()
+	for _, v := range schema.Validators {
+		if v.Title == title {
+			return v
+		}
+	}
+	t.Fatalf("validator %s not found", title)
+	return parser.PlutusValidator{}
+}
+
+func TestRun(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		validator string
+		inputs    Inputs
+		fails     bool
+	}{
+		{"spend", "market.listing.spend", Inputs{Redeemer: []byte(`"Buy"`)}, false},
+		{"spend with another redeemer", "market.listing.spend", Inputs{Redeemer: []byte(`"Cancel"`)}, true},
+		{"spend with an output reference", "market.listing.spend", Inputs{
+			Redeemer: []byte(`"Buy"`),
+			OutRef:   strings.Repeat("ab", 32) + "#1",
+		}, false},
+		{"mint", "market.listing.mint", Inputs{Redeemer: []byte(`"Burn"`)}, false},
+		{"withdraw", "oracle.feed.withdraw", Inputs{
+			Params:   []byte(`["abcd", "BTC", 6]`),
+			Redeemer: []byte(`{"Publish": {"price": 1, "timestamp": 2, "window": [0, 1]}}`),
+		}, false},
+		{"given context", "market.listing.spend", Inputs{
+			Context: []byte(`{"constructor": 0, "fields": [{"int": 0}, {"constructor": 1, "fields": []}, {"int": 0}]}`),
+		}, true},
+	}
+	for _, tt := range tests {
+		outcome, err := Run(schema, validator(t, schema, tt.validator), tt.inputs, uplc.DefaultBudget)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if tt.fails != (outcome.Err != nil) {
+			t.Errorf("%s: error = %v, want failure %v", tt.name, outcome.Err, tt.fails)
+		}
+		if outcome.Budget.CPU == 0 || outcome.Budget.Mem == 0 {
+			t.Errorf("%s: no budget spent", tt.name)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		validator string
+		inputs    Inputs
+		err       string
+	}{
+		{"no redeemer", "market.listing.spend", Inputs{}, "a redeemer is required"},
+		{"invalid redeemer", "market.listing.spend", Inputs{Redeemer: []byte(`"Sell"`)}, `invalid redeemer: $: unknown constructor "Sell"`},
+		{"datum without a schema", "market.listing.mint", Inputs{Datum: []byte(`0`), Redeemer: []byte(`0`)}, "takes no datum"},
+		{"missing parameters", "oracle.feed.withdraw", Inputs{Params: []byte(`[]`)}, "takes 3 parameters, got 0"},
+		{"bad output reference", "market.listing.spend", Inputs{Redeemer: []byte(`"Buy"`), OutRef: "00#0"}, "not a 32-byte transaction ID"},
+		{"context and redeemer", "market.listing.spend", Inputs{Redeemer: []byte(`"Buy"`), Context: []byte(`{"int": 0}`)}, "part of the given script context"},
+	}
+	for _, tt := range tests {
+		_, err := Run(schema, validator(t, schema, tt.validator), tt.inputs, uplc.DefaultBudget)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestMockContextMatchesSchema(t *testing.T) {
+	// The mocked transaction follows the Plutus V3 TxInfo shipped with the generators.
+	schema, err := generator.ScriptContextSchema(parser.PlutusV3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := encode.ParseJSON([]byte(`{
+		"inputs": [], "reference_inputs": [], "outputs": [], "fee": 0, "mint": [],
+		"certificates": [], "withdrawals": [],
+		"validity_range": {"from": {"bound": "NegInf", "closed": true}, "to": {"bound": "PosInf", "closed": true}},
+		"signatories": [], "redeemers": [], "datums": [],
+		"id": "` + strings.Repeat("00", 32) + `",
+		"votes": [], "proposal_procedures": [],
+		"current_treasury_amount": "None", "treasury_donation": "None"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := encode.Encode(value, &ir.Ref{Key: "TxInfo"}, types)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := emptyTxInfo(); !plutusdata.Equal(got, want) {
+		t.Errorf("emptyTxInfo() = %v, want %v", got, want)
+	}
+}
+
+func TestRunRejectsPlutusV2(t *testing.T) {
+	// V2 validators would be costed and run with the V3 cost model and builtins.
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v2_vesting.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := Inputs{Redeemer: []byte(`0`), Context: []byte(`{"int": 0}`)}
+	_, err = Run(schema, validator(t, schema, "vesting.vesting"), inputs, uplc.DefaultBudget)
+	if err == nil || !strings.Contains(err.Error(), "only Plutus v3 validators are supported") {
+		t.Errorf("error = %v, want Plutus v2 to be rejected", err)
+	}
+}
+
+func TestRunRejectsUnsupportedBuiltins(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The validator ignores its context and returns unit, but holds integerToByteString
+	// in a branch it never takes.
+	fn, _ := uplc.LookupBuiltin("integerToByteString")
+	unit := uplc.Const{Value: uplc.Unit{}}
+	script := &uplc.Script{PlutusVersion: parser.PlutusV3, Program: &uplc.Program{
+		Version: uplc.Version{Major: 1, Minor: 1},
+		Term: uplc.Lambda{Body: uplc.Apply{
+			Function: uplc.Lambda{Body: unit},
+			Argument: uplc.Delay{Term: uplc.Builtin{Function: fn}},
+		}},
+	}}
+	v := validator(t, schema, "market.listing.spend")
+	v.CompiledCode = script.CompiledCode()
+	_, err = Run(schema, v, Inputs{Redeemer: []byte(`"Buy"`)}, uplc.DefaultBudget)
+	if err == nil || !strings.Contains(err.Error(), "does not support: integerToByteString") {
+		t.Errorf("error = %v, want integerToByteString to be rejected", err)
+	}
+}
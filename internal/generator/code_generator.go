@@ -1,10 +1,27 @@
 package generator
 
-import "github.com/mgpai22/gogenesis/internal/parser"
+import (
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
 
 // CodeGenerator is the interface that all language-specific code generators must implement.
+// The generators are given the blueprint along with its definitions as resolved by Resolve.
 type CodeGenerator interface {
-	Generate(schema *parser.PlutusSchema, chosenNames map[string]string) (string, error)
+	Generate(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts GeneratorOptions) (string, error)
 
 	FileName() string
 }
+
+// FileSetGenerator is implemented by CodeGenerators that emit companion files next to
+// FileName, such as a module of shared types. The returned map is keyed by file name.
+type FileSetGenerator interface {
+	GenerateFiles(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts GeneratorOptions) (map[string]string, error)
+}
+
+// ProjectGenerator is implemented by CodeGenerators that can generate several projects
+// into one package. Given the merged definitions shared by all projects, it returns a
+// module per project holding the project's validators, keyed by file name.
+type ProjectGenerator interface {
+	GenerateProjects(projects []Project, shared *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts GeneratorOptions) (map[string]string, error)
+}
@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// descriptionLines splits a blueprint description into lines without trailing spaces or
+// leading and trailing blank lines.
+func descriptionLines(text string) []string {
+	lines := strings.Split(strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n")), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	if len(lines) == 1 && lines[0] == "" {
+		return nil
+	}
+	return lines
+}
+
+// JSDoc returns text as the lines of a JSDoc comment, or nothing when text is empty.
+func JSDoc(text string) []string {
+	lines := descriptionLines(text)
+	if len(lines) == 0 {
+		return nil
+	}
+	doc := []string{"/**"}
+	for _, line := range lines {
+		doc = append(doc, strings.TrimRight(" * "+escapeJSDoc(line), " "))
+	}
+	return append(doc, " */")
+}
+
+// InlineJSDoc returns text as a JSDoc comment on a single line followed by a space, for
+// documenting object keys within an expression, or "" when text is empty.
+func InlineJSDoc(text string) string {
+	var words []string
+	for _, line := range descriptionLines(text) {
+		if line = strings.TrimSpace(line); line != "" {
+			words = append(words, line)
+		}
+	}
+	if len(words) == 0 {
+		return ""
+	}
+	return "/** " + escapeJSDoc(strings.Join(words, " ")) + " */ "
+}
+
+// escapeJSDoc keeps text from closing the comment it is placed in.
+func escapeJSDoc(text string) string {
+	return strings.ReplaceAll(text, "*/", "*\\/")
+}
+
+// GoDoc returns text as Go comment lines, or nothing when text is empty.
+func GoDoc(text string) []string {
+	var doc []string
+	for _, line := range descriptionLines(text) {
+		doc = append(doc, strings.TrimRight("// "+line, " "))
+	}
+	return doc
+}
+
+// ConstructorDoc returns the documentation of a constructor: its description followed by
+// a list of its documented fields. Targets whose constructor fields cannot carry comments
+// of their own document them this way.
+func ConstructorDoc(cons *ir.Constructor) string {
+	var b strings.Builder
+	b.WriteString(cons.Description)
+	for _, field := range cons.Fields {
+		if field.Description == "" || field.Title == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("- `" + field.Title + "`: " + field.Description)
+	}
+	return b.String()
+}
+
+// ValidatorDoc returns the documentation of a validator: its description followed by a
+// list of its documented datum, redeemer and parameters.
+func ValidatorDoc(v parser.PlutusValidator) string {
+	var items []string
+	add := func(kind string, arg *parser.PlutusArgument) {
+		if arg == nil || arg.Description == "" {
+			return
+		}
+		if arg.Title != "" {
+			kind += " `" + arg.Title + "`"
+		}
+		items = append(items, "- "+kind+": "+arg.Description)
+	}
+	add("Datum", v.Datum)
+	add("Redeemer", v.Redeemer)
+	for i := range v.Parameters {
+		add("Parameter", &v.Parameters[i])
+	}
+	doc := strings.TrimSpace(v.Description)
+	if len(items) > 0 {
+		if doc != "" {
+			doc += "\n\n"
+		}
+		doc += strings.Join(items, "\n")
+	}
+	return doc
+}
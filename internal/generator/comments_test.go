@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestComments(t *testing.T) {
+	text := "\nFirst line */\n\nSecond line  \n"
+	if got, want := JSDoc(text), []string{"/**", " * First line *\\/", " *", " * Second line", " */"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("JSDoc() = %q, want %q", got, want)
+	}
+	if got, want := InlineJSDoc(text), "/** First line *\\/ Second line */ "; got != want {
+		t.Errorf("InlineJSDoc() = %q, want %q", got, want)
+	}
+	if got, want := GoDoc(text), []string{"// First line */", "//", "// Second line"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GoDoc() = %q, want %q", got, want)
+	}
+	if JSDoc(" \n") != nil || InlineJSDoc("") != "" || GoDoc("") != nil {
+		t.Errorf("empty descriptions produced comments")
+	}
+}
+
+func TestValidatorDoc(t *testing.T) {
+	v := parser.PlutusValidator{
+		Description: "Holds funds.",
+		Datum:       &parser.PlutusArgument{Title: "datum", Description: "The escrow"},
+		Redeemer:    &parser.PlutusArgument{Title: "redeemer"},
+		Parameters:  []parser.PlutusArgument{{Title: "owner", Description: "Who may withdraw"}},
+	}
+	want := "Holds funds.\n\n- Datum `datum`: The escrow\n- Parameter `owner`: Who may withdraw"
+	if got := ValidatorDoc(v); got != want {
+		t.Errorf("ValidatorDoc() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+)
+
+// Components returns the strongly connected components of the graph over nodes whose
+// edges are given by deps. Every component comes after the components it depends on, so
+// definitions can be emitted in the returned order; only the members of a recursive
+// component (see IsRecursive) refer to definitions that are not yet emitted. Nodes are
+// visited in the given order and edges in the order deps returns them, so the result is
+// deterministic for deterministic input. Edges to nodes outside nodes are ignored.
+func Components(nodes []string, deps func(string) []string) [][]string {
+	inGraph := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		inGraph[node] = true
+	}
+	// Tarjan's algorithm.
+	index := make(map[string]int, len(nodes))
+	lowLink := make(map[string]int, len(nodes))
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+	var visit func(node string)
+	visit = func(node string) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+		for _, dep := range deps(node) {
+			if !inGraph[dep] {
+				continue
+			}
+			if _, seen := index[dep]; !seen {
+				visit(dep)
+				lowLink[node] = min(lowLink[node], lowLink[dep])
+			} else if onStack[dep] {
+				lowLink[node] = min(lowLink[node], index[dep])
+			}
+		}
+		if lowLink[node] != index[node] {
+			return
+		}
+		var component []string
+		for {
+			member := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[member] = false
+			component = append(component, member)
+			if member == node {
+				break
+			}
+		}
+		sort.Strings(component)
+		components = append(components, component)
+	}
+	for _, node := range nodes {
+		if _, seen := index[node]; !seen {
+			visit(node)
+		}
+	}
+	return components
+}
+
+// IsRecursive reports whether a component refers back to itself: it has several members,
+// or its only member depends on itself.
+func IsRecursive(component []string, deps func(string) []string) bool {
+	if len(component) > 1 {
+		return true
+	}
+	for _, dep := range deps(component[0]) {
+		if dep == component[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// DefinitionComponents returns the strongly connected components of the dependency graph
+// of the definitions of types (see CollectDependenciesMemo and Components), together
+// with the function returning the dependencies of a definition.
+func DefinitionComponents(types *ir.Schema) ([][]string, func(string) []string) {
+	refNames := make([]string, 0, len(types.Definitions))
+	for refName := range types.Definitions {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	memo := make(map[string][]string)
+	deps := func(refName string) []string {
+		return CollectDependenciesMemo(refName, types, memo)
+	}
+	return Components(refNames, deps), deps
+}
+
+// BaseConstructors returns the positions of the constructors of the definition refName
+// whose fields do not lead back to refName, so that random values of a recursive type
+// can bottom out by choosing one of them. Every constructor of a definition that is not
+// recursive is a base constructor.
+func BaseConstructors(refName string, types *ir.Schema) []int {
+	def, ok := types.Definitions[refName]
+	if !ok {
+		return nil
+	}
+	components, deps := DefinitionComponents(types)
+	cycle := make(map[string]bool)
+	for _, component := range components {
+		for _, member := range component {
+			if member == refName && IsRecursive(component, deps) {
+				for _, m := range component {
+					cycle[m] = true
+				}
+			}
+		}
+	}
+	var base []int
+	for i, cons := range ir.Constructors(def.Type) {
+		recursive := false
+		for _, dep := range Dependencies(&ir.Product{Constructor: cons}, types) {
+			recursive = recursive || cycle[dep]
+		}
+		if !recursive {
+			base = append(base, i)
+		}
+	}
+	return base
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestComponents(t *testing.T) {
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c", "d"},
+		"c": {"b"},
+		"d": {},
+		"e": {"e", "a"},
+		"f": {"missing"},
+	}
+	deps := func(node string) []string { return graph[node] }
+	got := Components([]string{"a", "b", "c", "d", "e", "f"}, deps)
+	want := [][]string{{"d"}, {"b", "c"}, {"a"}, {"e"}, {"f"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Components() = %v, want %v", got, want)
+	}
+	for i, recursive := range []bool{false, true, false, true, false} {
+		if IsRecursive(got[i], deps) != recursive {
+			t.Errorf("IsRecursive(%v) = %v, want %v", got[i], !recursive, recursive)
+		}
+	}
+}
+
+func TestBaseConstructors(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/recursive.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string][]int{
+		"expr/Expr":              {0},
+		"json/Value":             {2, 3},
+		"Option$expr/Statement":  {1},
+		"cardano/does/not/Exist": nil,
+		"expr/Binding":           nil,
+	}
+	for refName, want := range tests {
+		if got := BaseConstructors(refName, types); !reflect.DeepEqual(got, want) {
+			t.Errorf("BaseConstructors(%s) = %v, want %v", refName, got, want)
+		}
+	}
+}
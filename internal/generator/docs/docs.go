@@ -0,0 +1,547 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// DocsGenerator renders a reference of a blueprint's validators and definitions, as
+// Markdown and as a standalone HTML page.
+type DocsGenerator struct{}
+
+func NewDocsGenerator() *DocsGenerator {
+	return &DocsGenerator{}
+}
+
+func (d *DocsGenerator) FileName() string {
+	return "plutus-docs.md"
+}
+
+// docsPage is the name, without extension, of the page FileName and its HTML rendering
+// are written to.
+const docsPage = "plutus-docs"
+
+// Generate returns the reference as Markdown.
+func (d *DocsGenerator) Generate(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (string, error) {
+	return renderMarkdown(buildDocument(schema, types, chosenNames, "")), nil
+}
+
+// GenerateFiles returns the reference as Markdown and as HTML.
+func (d *DocsGenerator) GenerateFiles(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	return pages(docsPage, buildDocument(schema, types, chosenNames, "")), nil
+}
+
+// GenerateProjects returns a page per project documenting its validators. Their types
+// link to the shared definitions documented by GenerateFiles.
+func (d *DocsGenerator) GenerateProjects(projects []generator.Project, shared *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	files := make(map[string]string)
+	for _, project := range projects {
+		doc := buildDocument(&parser.PlutusSchema{Preamble: project.Schema.Preamble, Validators: project.Schema.Validators, SourceHashes: project.Schema.SourceHashes}, types, chosenNames, docsPage)
+		for name, page := range pages(generator.ProjectFileStem(project.Name, "-"), doc) {
+			files[name] = page
+		}
+	}
+	return files, nil
+}
+
+// GenerateScriptContext returns a page documenting the script context types of a Plutus
+// version.
+func (d *DocsGenerator) GenerateScriptContext(version string, schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	return pages("plutus-context-"+version, buildDocument(schema, types, chosenNames, "")), nil
+}
+
+// pages renders doc as name.md and name.html.
+func pages(name string, doc document) map[string]string {
+	return map[string]string{
+		name + ".md":   renderMarkdown(doc),
+		name + ".html": renderHTML(doc),
+	}
+}
+
+//
+// --- Document model ---
+//
+
+// span is a piece of inline text: plain text, code, Markdown taken from the blueprint,
+// or a link to an anchor, on page if it is set.
+type span struct {
+	text   string
+	code   bool
+	raw    bool
+	target string
+	page   string
+}
+
+type inline []span
+
+func text(s string) inline { return inline{{text: s}} }
+func code(s string) inline { return inline{{text: s, code: true}} }
+
+// markdown joins the lines of a blueprint description for use in a table cell.
+func markdown(s string) inline {
+	return inline{{text: strings.Join(strings.Fields(s), " "), raw: true}}
+}
+
+// block is one of heading, paragraph, description, table or list.
+type block interface{}
+
+type heading struct {
+	level  int
+	anchor string
+	text   inline
+}
+
+type paragraph struct {
+	text inline
+}
+
+// description is documentation taken verbatim from the blueprint, usually Markdown.
+type description struct {
+	text string
+}
+
+type table struct {
+	header []string
+	rows   [][]inline
+}
+
+type list struct {
+	items []inline
+}
+
+// document is a page of the reference. Its header is the generated file header (see
+// generator.FileHeader).
+type document struct {
+	header []string
+	title  string
+	blocks []block
+}
+
+// builder accumulates the document for one blueprint. Definitions are documented on
+// defsPage if it is set, and in the document itself otherwise.
+type builder struct {
+	types       *ir.Schema
+	chosenNames map[string]string
+	defsPage    string
+	anchors     map[string]string
+	blocks      []block
+}
+
+func (b *builder) add(blocks ...block) {
+	b.blocks = append(b.blocks, blocks...)
+}
+
+func (b *builder) describe(text string) {
+	if strings.TrimSpace(text) != "" {
+		b.add(description{text: strings.TrimSpace(text)})
+	}
+}
+
+// buildDocument lays out the reference of the validators of schema and of the definitions
+// of types, or only of the validators if the definitions are documented on defsPage.
+func buildDocument(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, defsPage string) document {
+	b := &builder{types: types, chosenNames: chosenNames, defsPage: defsPage, anchors: make(map[string]string)}
+	title := schema.Preamble.Title
+	if title == "" {
+		title = "Blueprint"
+	}
+	b.add(heading{level: 1, text: text(title)})
+	b.describe(schema.Preamble.Description)
+	var facts []inline
+	if schema.Preamble.Version != "" {
+		facts = append(facts, append(text("Version: "), code(schema.Preamble.Version)...))
+	}
+	facts = append(facts, append(text("Plutus version: "), code(schema.PlutusVersion())...))
+	if c := schema.Preamble.Compiler; c.Name != "" {
+		facts = append(facts, append(text("Compiler: "), code(strings.TrimSpace(c.Name+" "+c.Version))...))
+	}
+	if schema.Preamble.License != "" {
+		facts = append(facts, append(text("License: "), code(schema.Preamble.License)...))
+	}
+	b.add(list{items: facts})
+
+	refNames := make([]string, 0, len(types.Definitions))
+	for refName := range types.Definitions {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	b.assignAnchors(schema.Validators, refNames)
+
+	if len(schema.Validators) > 0 {
+		b.add(heading{level: 2, anchor: "validators", text: text("Validators")})
+		var index []inline
+		for _, v := range schema.Validators {
+			index = append(index, inline{{text: v.Title, code: true, target: b.anchors[v.Title]}})
+		}
+		b.add(list{items: index})
+		for _, v := range schema.Validators {
+			b.validator(v, schema.PlutusVersion())
+		}
+	}
+
+	if len(refNames) > 0 && defsPage == "" {
+		b.add(heading{level: 2, anchor: "definitions", text: text("Definitions")})
+		var index []inline
+		for _, refName := range refNames {
+			index = append(index, append(b.refLink(refName), append(text(" — "), code(refName)...)...))
+		}
+		b.add(list{items: index})
+		for _, refName := range refNames {
+			b.definition(types.Definitions[refName])
+		}
+	}
+	return document{header: generator.FileHeader(schema), title: title, blocks: b.blocks}
+}
+
+// validator documents a validator.
+func (b *builder) validator(v parser.PlutusValidator, plutusVersion string) {
+	b.add(heading{level: 3, anchor: b.anchors[v.Title], text: code(v.Title)})
+	b.describe(v.Description)
+	facts := []inline{}
+	if purpose := v.Purpose(); purpose != "" {
+		facts = append(facts, append(text("Purpose: "), code(purpose)...))
+	}
+	facts = append(facts,
+		append(text("Plutus version: "), code(plutusVersion)...),
+		append(text("Script hash: "), code(v.Hash)...),
+		text(fmt.Sprintf("Script size: %d bytes", len(v.CompiledCode)/2)),
+	)
+	b.add(list{items: facts})
+
+	var rows [][]inline
+	argument := func(kind string, arg *parser.PlutusArgument) {
+		if arg == nil {
+			return
+		}
+		rows = append(rows, []inline{text(kind), code(arg.Title), b.typeOf(b.types.Convert(arg.Schema)), markdown(arg.Description)})
+	}
+	argument("Datum", v.Datum)
+	argument("Redeemer", v.Redeemer)
+	for i := range v.Parameters {
+		argument(fmt.Sprintf("Parameter %d", i+1), &v.Parameters[i])
+	}
+	if len(rows) > 0 {
+		b.add(table{header: []string{"Argument", "Name", "Type", "Description"}, rows: rows})
+	}
+}
+
+// definition documents a definition.
+func (b *builder) definition(def *ir.Definition) {
+	b.add(heading{level: 3, anchor: b.anchors[def.Key], text: text(b.name(def.Key))})
+	b.add(paragraph{text: append(text("Reference: "), code(def.Key)...)})
+	b.describe(def.Description)
+	switch t := def.Type.(type) {
+	case *ir.Product:
+		cons := t.Constructor
+		b.add(paragraph{text: text(fmt.Sprintf("Record with constructor index %d.", cons.Index))})
+		if cons.Description != def.Description {
+			b.describe(cons.Description)
+		}
+		b.fields(cons.Fields)
+	case *ir.Sum:
+		b.add(paragraph{text: text(fmt.Sprintf("One of %d constructors.", len(t.Constructors)))})
+		var rows [][]inline
+		for _, cons := range t.Constructors {
+			var fields inline
+			for j, field := range cons.Fields {
+				if j > 0 {
+					fields = append(fields, text(", ")...)
+				}
+				if field.Title != "" {
+					fields = append(fields, code(field.Title)...)
+					fields = append(fields, text(": ")...)
+				}
+				fields = append(fields, b.typeOf(field.Type)...)
+			}
+			rows = append(rows, []inline{
+				text(fmt.Sprint(cons.Index)),
+				code(cons.Title),
+				fields,
+				markdown(cons.Description),
+			})
+		}
+		b.add(table{header: []string{"Index", "Constructor", "Fields", "Description"}, rows: rows})
+		for _, cons := range t.Constructors {
+			if hasFieldDescriptions(cons) {
+				b.add(paragraph{text: append(text("Fields of "), append(code(cons.Title), text(":")...)...)})
+				b.fields(cons.Fields)
+			}
+		}
+	default:
+		b.add(paragraph{text: append(text("Type: "), b.typeOf(t)...)})
+	}
+}
+
+// fields documents the fields of a record.
+func (b *builder) fields(fields []*ir.Field) {
+	if len(fields) == 0 {
+		b.add(paragraph{text: text("No fields.")})
+		return
+	}
+	var rows [][]inline
+	for i, field := range fields {
+		name := code(field.Title)
+		if field.Title == "" {
+			name = text(fmt.Sprint(i))
+		}
+		rows = append(rows, []inline{name, b.typeOf(field.Type), markdown(field.Description)})
+	}
+	b.add(table{header: []string{"Field", "Type", "Description"}, rows: rows})
+}
+
+// hasFieldDescriptions reports whether any field of cons is documented.
+func hasFieldDescriptions(cons *ir.Constructor) bool {
+	for _, field := range cons.Fields {
+		if strings.TrimSpace(field.Description) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// assignAnchors gives every validator and definition a distinct anchor.
+func (b *builder) assignAnchors(validators []parser.PlutusValidator, refNames []string) {
+	used := make(map[string]bool)
+	assign := func(key, prefix string) {
+		base := prefix + "-" + strings.Trim(nonAnchorChars.ReplaceAllString(strings.ToLower(key), "-"), "-")
+		id := base
+		for n := 2; used[id]; n++ {
+			id = fmt.Sprintf("%s-%d", base, n)
+		}
+		used[id] = true
+		b.anchors[key] = id
+	}
+	for _, v := range validators {
+		assign(v.Title, "validator")
+	}
+	for _, refName := range refNames {
+		assign(refName, "definition")
+	}
+}
+
+// name returns the display name of a definition.
+func (b *builder) name(refName string) string {
+	if name, ok := b.chosenNames[refName]; ok {
+		return name
+	}
+	return refName
+}
+
+// refLink returns a link to the definition refName.
+func (b *builder) refLink(refName string) inline {
+	if _, ok := b.types.Definitions[refName]; !ok {
+		return code(refName)
+	}
+	return inline{{text: b.name(refName), target: b.anchors[refName], page: b.defsPage}}
+}
+
+// typeOf describes a type, linking to the definitions it refers to.
+func (b *builder) typeOf(t ir.Type) inline {
+	generic := func(name string, args ...ir.Type) inline {
+		out := text(name + "<")
+		for i, arg := range args {
+			if i > 0 {
+				out = append(out, text(", ")...)
+			}
+			out = append(out, b.typeOf(arg)...)
+		}
+		return append(out, text(">")...)
+	}
+	switch t := t.(type) {
+	case *ir.Ref:
+		return b.refLink(t.Key)
+	case *ir.Sum, *ir.Product:
+		return text("inline constructors")
+	case *ir.Tuple:
+		return generic("Tuple", t.Items...)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return text("Int")
+		case ir.Bytes:
+			return text("ByteArray")
+		case ir.String:
+			return text("String")
+		case ir.Boolean:
+			return text("Bool")
+		case ir.Unit:
+			return text("Void")
+		}
+	case *ir.List:
+		return generic("List", t.Items)
+	case *ir.Map:
+		return generic("Map", t.Keys, t.Values)
+	case *ir.Pair:
+		return generic("Pair", t.Left, t.Right)
+	}
+	return text("Data")
+}
+
+var nonAnchorChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+//
+// --- Markdown ---
+//
+
+// href returns the link target of sp, naming its page with the extension ext.
+func href(sp span, ext string) string {
+	if sp.page == "" {
+		return "#" + sp.target
+	}
+	return sp.page + ext + "#" + sp.target
+}
+
+var markdownEscaper = strings.NewReplacer("\\", "\\\\", "|", "\\|", "<", "&lt;", ">", "&gt;", "*", "\\*", "_", "\\_", "[", "\\[", "]", "\\]")
+
+func (s inline) markdown() string {
+	var out strings.Builder
+	for _, sp := range s {
+		t := markdownEscaper.Replace(sp.text)
+		if sp.raw {
+			t = strings.ReplaceAll(sp.text, "|", "\\|")
+		}
+		if sp.code {
+			t = "`" + strings.ReplaceAll(sp.text, "|", "\\|") + "`"
+		}
+		if sp.target != "" {
+			t = "[" + t + "](" + href(sp, ".md") + ")"
+		}
+		out.WriteString(t)
+	}
+	return out.String()
+}
+
+func renderMarkdown(doc document) string {
+	var out strings.Builder
+	for _, line := range doc.header {
+		out.WriteString("<!-- " + line + " -->\n")
+	}
+	out.WriteString("\n")
+	for _, blk := range doc.blocks {
+		switch blk := blk.(type) {
+		case heading:
+			if blk.anchor != "" {
+				out.WriteString(fmt.Sprintf("<a id=\"%s\"></a>\n\n", blk.anchor))
+			}
+			out.WriteString(strings.Repeat("#", blk.level) + " " + blk.text.markdown() + "\n\n")
+		case paragraph:
+			out.WriteString(blk.text.markdown() + "\n\n")
+		case description:
+			out.WriteString(blk.text + "\n\n")
+		case list:
+			for _, item := range blk.items {
+				out.WriteString("- " + item.markdown() + "\n")
+			}
+			out.WriteString("\n")
+		case table:
+			out.WriteString("| " + strings.Join(blk.header, " | ") + " |\n")
+			out.WriteString(strings.Repeat("| --- ", len(blk.header)) + "|\n")
+			for _, row := range blk.rows {
+				cells := make([]string, len(row))
+				for i, cell := range row {
+					cells[i] = cell.markdown()
+				}
+				out.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+			}
+			out.WriteString("\n")
+		}
+	}
+	return strings.TrimRight(out.String(), "\n") + "\n"
+}
+
+//
+// --- HTML ---
+//
+
+func (s inline) html() string {
+	var out strings.Builder
+	for _, sp := range s {
+		t := html.EscapeString(sp.text)
+		if sp.raw {
+			t = inlineCode.ReplaceAllString(t, "<code>$1</code>")
+		}
+		if sp.code {
+			t = "<code>" + t + "</code>"
+		}
+		if sp.target != "" {
+			t = fmt.Sprintf("<a href=\"%s\">%s</a>", href(sp, ".html"), t)
+		}
+		out.WriteString(t)
+	}
+	return out.String()
+}
+
+var inlineCode = regexp.MustCompile("`([^`]+)`")
+
+// descriptionHTML renders a blueprint description: paragraphs, with inline code.
+func descriptionHTML(text string) string {
+	var out strings.Builder
+	for _, para := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		if para = strings.TrimSpace(para); para == "" {
+			continue
+		}
+		escaped := inlineCode.ReplaceAllString(html.EscapeString(para), "<code>$1</code>")
+		out.WriteString("<p>" + strings.ReplaceAll(escaped, "\n", "<br>\n") + "</p>\n")
+	}
+	return out.String()
+}
+
+const htmlStyle = `body { font-family: system-ui, sans-serif; max-width: 60rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; }
+code { background: #f3f3f3; padding: 0 0.2em; border-radius: 3px; }
+table { border-collapse: collapse; margin: 1rem 0; }
+th, td { border: 1px solid #ddd; padding: 0.3rem 0.6rem; text-align: left; vertical-align: top; }
+h3 { margin-top: 2rem; border-top: 1px solid #eee; padding-top: 1rem; }`
+
+func renderHTML(doc document) string {
+	var out strings.Builder
+	out.WriteString("<!DOCTYPE html>\n")
+	for _, line := range doc.header {
+		out.WriteString("<!-- " + html.EscapeString(line) + " -->\n")
+	}
+	out.WriteString("<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	out.WriteString("<title>" + html.EscapeString(doc.title) + "</title>\n")
+	out.WriteString("<style>\n" + htmlStyle + "\n</style>\n</head>\n<body>\n")
+	for _, blk := range doc.blocks {
+		switch blk := blk.(type) {
+		case heading:
+			id := ""
+			if blk.anchor != "" {
+				id = fmt.Sprintf(" id=\"%s\"", blk.anchor)
+			}
+			out.WriteString(fmt.Sprintf("<h%d%s>%s</h%d>\n", blk.level, id, blk.text.html(), blk.level))
+		case paragraph:
+			out.WriteString("<p>" + blk.text.html() + "</p>\n")
+		case description:
+			out.WriteString(descriptionHTML(blk.text))
+		case list:
+			out.WriteString("<ul>\n")
+			for _, item := range blk.items {
+				out.WriteString("<li>" + item.html() + "</li>\n")
+			}
+			out.WriteString("</ul>\n")
+		case table:
+			out.WriteString("<table>\n<tr>")
+			for _, h := range blk.header {
+				out.WriteString("<th>" + html.EscapeString(h) + "</th>")
+			}
+			out.WriteString("</tr>\n")
+			for _, row := range blk.rows {
+				out.WriteString("<tr>")
+				for _, cell := range row {
+					out.WriteString("<td>" + cell.html() + "</td>")
+				}
+				out.WriteString("</tr>\n")
+			}
+			out.WriteString("</table>\n")
+		}
+	}
+	out.WriteString("</body>\n</html>\n")
+	return out.String()
+}
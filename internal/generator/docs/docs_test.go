@@ -0,0 +1,156 @@
+package docs
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/internal/version"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestMain pins the version written in file headers, which release builds stamp through
+// -ldflags, to the one in the golden files.
+func TestMain(m *testing.M) {
+	version.Version, version.CommitHash = "", ""
+	os.Exit(m.Run())
+}
+
+func TestGenerateGolden(t *testing.T) {
+	blueprints, err := filepath.Glob("../../../testdata/blueprints/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range blueprints {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		t.Run(name, func(t *testing.T) {
+			schema, err := parser.ParsePlutusJSON(path)
+			if err != nil {
+				t.Fatalf("failed to parse blueprint: %v", err)
+			}
+			out := t.TempDir()
+			opts := generator.GeneratorOptions{
+				Language:  "docs",
+				WellKnown: generator.DefaultWellKnownRegistry(),
+			}
+			g := generator.NewGeneratorWithOptions(out, opts, NewDocsGenerator())
+			if err := g.Generate(schema); err != nil {
+				t.Fatalf("generation failed: %v", err)
+			}
+			compareGolden(t, out, filepath.Join("testdata", name))
+		})
+	}
+}
+
+func TestGenerateProjectsGolden(t *testing.T) {
+	var projects []generator.Project
+	for _, name := range []string{"v3_market", "shared_escrow", "combinators"} {
+		schema, err := parser.ParsePlutusJSON(filepath.Join("../../../testdata/blueprints", name+".json"))
+		if err != nil {
+			t.Fatalf("failed to parse blueprint %s: %v", name, err)
+		}
+		projects = append(projects, generator.Project{Name: name, Schema: schema})
+	}
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:  "docs",
+		WellKnown: generator.DefaultWellKnownRegistry(),
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewDocsGenerator())
+	if err := g.GenerateProjects(projects); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "merged"))
+}
+
+func TestDefinitionLinks(t *testing.T) {
+	schema := &parser.PlutusSchema{Definitions: map[string]parser.PlutusDefinition{
+		"Int":      {DataType: "integer"},
+		"List$Int": {DataType: "list", Items: &parser.PlutusDefinition{Ref: "#/definitions/Int"}},
+		"a/Box": {AnyOf: []parser.PlutusDefinition{{
+			Title: "Box", DataType: "constructor",
+			Fields: []parser.PlutusField{{Title: "items", Ref: "#/definitions/List$Int"}},
+		}}},
+	}}
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]string{"Int": "Int", "List$Int": "ListInt", "a/Box": "Box"}
+	out := renderMarkdown(buildDocument(schema, types, names, ""))
+	for _, want := range []string{
+		`<a id="definition-a-box"></a>`,
+		"| `items` | [ListInt](#definition-list-int) |",
+		"Type: List&lt;[Int](#definition-int)&gt;",
+		"Record with constructor index 0.",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateScriptContextGolden(t *testing.T) {
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:       "docs",
+		WellKnown:      generator.DefaultWellKnownRegistry(),
+		ScriptContexts: generator.ScriptContextVersions,
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewDocsGenerator())
+	if err := g.Generate(&parser.PlutusSchema{}); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "script_context"))
+}
+
+// compareGolden checks that every file generated into dir matches the file of the same
+// name in goldenDir, rewriting goldenDir instead when -update is set.
+func compareGolden(t *testing.T, dir, goldenDir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, entry := range entries {
+		got, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		goldenPath := filepath.Join(goldenDir, entry.Name())
+		if *update {
+			if err := os.WriteFile(goldenPath, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("missing golden file (run with -update): %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s differs from %s (run with -update to accept)", entry.Name(), goldenPath)
+		}
+	}
+	goldens, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(goldens) != len(entries) {
+		t.Errorf("generated %d files, golden directory holds %d", len(entries), len(goldens))
+	}
+}
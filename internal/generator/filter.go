@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// Filter selects the part of a blueprint to generate.
+type Filter struct {
+	// Validators holds validator titles or glob patterns (see path.Match). When set, only
+	// matching validators are generated.
+	Validators []string
+	// Refs holds definition references that are generated whether or not a selected
+	// validator uses them.
+	Refs []string
+	// Exclude holds glob patterns matched against definition references. Excluded
+	// definitions are generated as opaque Data, so nothing they refer to is needed.
+	Exclude []string
+}
+
+// IsZero reports whether the filter keeps the whole blueprint.
+func (f Filter) IsZero() bool {
+	return len(f.Validators) == 0 && len(f.Refs) == 0 && len(f.Exclude) == 0
+}
+
+// Apply returns a copy of schema reduced to what the filter selects. When validators or
+// references are selected, only the definitions reachable from them are kept.
+func (f Filter) Apply(schema *parser.PlutusSchema) (*parser.PlutusSchema, error) {
+	filtered := *schema
+	filtered.Definitions = make(map[string]parser.PlutusDefinition, len(schema.Definitions))
+	for refName, def := range schema.Definitions {
+		excluded, err := matchAny(f.Exclude, refName)
+		if err != nil {
+			return nil, err
+		}
+		if excluded {
+			def = parser.PlutusDefinition{Title: def.Title, Description: def.Description}
+		}
+		filtered.Definitions[refName] = def
+	}
+	if len(f.Validators) == 0 && len(f.Refs) == 0 {
+		return &filtered, nil
+	}
+
+	filtered.Validators = nil
+	for _, pattern := range f.Validators {
+		matched := false
+		for _, v := range schema.Validators {
+			ok, err := path.Match(pattern, v.Title)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validator pattern %s: %w", pattern, err)
+			}
+			matched = matched || ok
+		}
+		if !matched {
+			return nil, fmt.Errorf("no validator matches %s", pattern)
+		}
+	}
+	for _, v := range schema.Validators {
+		// The patterns were validated above.
+		if selected, _ := matchAny(f.Validators, v.Title); selected {
+			filtered.Validators = append(filtered.Validators, v)
+		}
+	}
+
+	// Walk the dependency graph of the resolved definitions from the selected validators
+	// and references.
+	types, err := ir.Build(filtered.Definitions)
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[string]bool)
+	var visit func(t ir.Type)
+	visit = func(t ir.Type) {
+		deps, inlined := dependencies(t, types)
+		// Inlined lists and maps are not dependencies of their own, but generators look
+		// them up.
+		for _, refName := range inlined {
+			keep[refName] = true
+		}
+		for _, dep := range deps {
+			if !keep[dep] {
+				keep[dep] = true
+				visit(types.Definitions[dep].Type)
+			}
+		}
+	}
+	for _, ref := range f.Refs {
+		if _, ok := types.Definitions[ref]; !ok {
+			return nil, fmt.Errorf("no definition %s", ref)
+		}
+		visit(&ir.Ref{Key: ref})
+	}
+	for _, v := range filtered.Validators {
+		args := []*parser.PlutusArgument{v.Datum, v.Redeemer}
+		for i := range v.Parameters {
+			args = append(args, &v.Parameters[i])
+		}
+		for _, arg := range args {
+			if arg != nil {
+				visit(types.Convert(arg.Schema))
+			}
+		}
+	}
+	for refName := range filtered.Definitions {
+		if !keep[refName] {
+			delete(filtered.Definitions, refName)
+		}
+	}
+	return &filtered, nil
+}
+
+// matchAny reports whether name matches any of the glob patterns.
+func matchAny(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		ok, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestFilterApply(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		filter     Filter
+		validators []string
+		defs       []string
+		opaque     []string
+	}{
+		{
+			name:       "validator",
+			filter:     Filter{Validators: []string{"market.listing.mint"}},
+			validators: []string{"market.listing.mint"},
+			defs:       []string{"Int", "Pairs$cardano/assets/PolicyId_Int", "cardano/assets/PolicyId", "market/MintAction"},
+		},
+		{
+			name:       "validator glob with refs",
+			filter:     Filter{Validators: []string{"oracle.*"}, Refs: []string{"Tuple$Int_Int"}},
+			validators: []string{"oracle.feed.withdraw"},
+			defs:       []string{"Int", "String", "Tuple$Int_Int", "aiken/crypto/VerificationKeyHash", "oracle/FeedRedeemer"},
+		},
+		{
+			name:       "inlined collections and excludes",
+			filter:     Filter{Validators: []string{"market.listing.spend"}, Exclude: []string{"cardano/address/*"}},
+			validators: []string{"market.listing.spend"},
+			defs:       []string{"ByteArray", "Int", "List$Pair$ByteArray_Int", "Pair$ByteArray_Int", "cardano/address/Address", "market/Action", "market/Listing"},
+			opaque:     []string{"cardano/address/Address"},
+		},
+	}
+	for _, tt := range tests {
+		filtered, err := tt.filter.Apply(schema)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var validators []string
+		for _, v := range filtered.Validators {
+			validators = append(validators, v.Title)
+		}
+		if !reflect.DeepEqual(validators, tt.validators) {
+			t.Errorf("%s: validators = %v, want %v", tt.name, validators, tt.validators)
+		}
+		var defs []string
+		for refName := range filtered.Definitions {
+			defs = append(defs, refName)
+		}
+		sort.Strings(defs)
+		if !reflect.DeepEqual(defs, tt.defs) {
+			t.Errorf("%s: definitions = %v, want %v", tt.name, defs, tt.defs)
+		}
+		for _, refName := range tt.opaque {
+			if def := filtered.Definitions[refName]; len(def.AnyOf) > 0 || def.DataType != "" {
+				t.Errorf("%s: excluded definition %s not opaque: %+v", tt.name, refName, def)
+			}
+		}
+	}
+	if len(schema.Validators) != 3 {
+		t.Errorf("Apply modified the blueprint")
+	}
+}
+
+func TestFilterApplyErrors(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filter := range []Filter{
+		{Validators: []string{"missing.*"}},
+		{Refs: []string{"Missing"}},
+		{Exclude: []string{"["}},
+	} {
+		if _, err := filter.Apply(schema); err == nil {
+			t.Errorf("Apply(%+v) succeeded, want an error", filter)
+		}
+	}
+}
+
+func TestFilterApplyMapWithoutKeys(t *testing.T) {
+	// An inlined map may leave its keys and values unspecified, in which case they are
+	// any data.
+	schema := &parser.PlutusSchema{
+		Validators: []parser.PlutusValidator{{
+			Title:    "pool.spend",
+			Redeemer: &parser.PlutusArgument{Schema: parser.PlutusDefinition{Ref: "#/definitions/Pairs$Int_Int"}},
+		}},
+		Definitions: map[string]parser.PlutusDefinition{
+			"Pairs$Int_Int": {DataType: "map"},
+			"Unused":        {DataType: "integer"},
+		},
+	}
+	filtered, err := Filter{Validators: []string{"pool.spend"}}.Apply(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := filtered.Definitions["Pairs$Int_Int"]; !ok || len(filtered.Definitions) != 1 {
+		t.Errorf("definitions = %v, want only Pairs$Int_Int", filtered.Definitions)
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/mgpai22/gogenesis/internal/ir"
 	"github.com/mgpai22/gogenesis/internal/parser"
 )
 
@@ -16,6 +18,30 @@ type GeneratorOptions struct {
 	ReservedNames map[string]bool
 	// You can add a Language field here if you want to also carry that info.
 	Language string
+	// WellKnown maps definitions onto SDK-provided types instead of regenerating them.
+	// A nil registry disables the mapping.
+	WellKnown *WellKnownRegistry
+	// WrappedRedeemers overrides the detection of multi-validator redeemer wrappers:
+	// a definition reference mapped to true is always treated as a wrapper, one mapped
+	// to false never is.
+	WrappedRedeemers map[string]bool
+	// ScriptContexts lists the Plutus versions, such as "v3", whose script context types
+	// are generated next to the blueprint's types.
+	ScriptContexts []string
+	// PropertyTests adds generators of random values of every type, and tests checking
+	// that they survive a roundtrip through CBOR, to the generated code.
+	PropertyTests bool
+	// Examples makes the Go generator add a program printing the CBOR encoding of each
+	// example, built as a value of the generated types. The conformance tests use it.
+	Examples []Example
+}
+
+// Example is a value of a definition, written in the JSON accepted by the encode
+// package and decoded with its numbers kept as json.Number.
+type Example struct {
+	// Ref is the definition key, such as "market/Listing".
+	Ref   string
+	Value interface{}
 }
 
 var defaultReservedNames = map[string]bool{
@@ -23,6 +49,45 @@ var defaultReservedNames = map[string]bool{
 	"Dummy": true,
 }
 
+// languageReservedNames lists names taken by the runtime support code of a target language.
+var languageReservedNames = map[string]map[string]bool{
+	// Exporting these from a TypeScript module would shadow the standard globals.
+	"typescript": {
+		"Array":   true,
+		"BigInt":  true,
+		"Boolean": true,
+		"Error":   true,
+		"Map":     true,
+		"Number":  true,
+		"Object":  true,
+		"Set":     true,
+		"String":  true,
+		"Symbol":  true,
+	},
+	"golang": {
+		"Constr":              true,
+		"Pair":                true,
+		"PlutusDataMarshaler": true,
+		"EncodeData":          true,
+		"Validator":           true,
+	},
+}
+
+// ReservedNames returns the names that the generated code of a target language, such as
+// "golang", must not declare: the default reserved names and those taken by the runtime
+// support code of the language.
+func ReservedNames(language string) map[string]bool {
+	extra := languageReservedNames[language]
+	reserved := make(map[string]bool, len(defaultReservedNames)+len(extra))
+	for name := range defaultReservedNames {
+		reserved[name] = true
+	}
+	for name := range extra {
+		reserved[name] = true
+	}
+	return reserved
+}
+
 // Generator is the master generator that delegates to a CodeGenerator.
 type Generator struct {
 	OutputDir string
@@ -39,7 +104,7 @@ func NewGenerator(outputDir string) *Generator {
 // NewGeneratorWithOptions creates a new Generator with custom options and a chosen CodeGenerator.
 func NewGeneratorWithOptions(outputDir string, opts GeneratorOptions, codeGen CodeGenerator) *Generator {
 	if opts.ReservedNames == nil {
-		opts.ReservedNames = defaultReservedNames
+		opts.ReservedNames = ReservedNames(opts.Language)
 	}
 	// // If no CodeGenerator is provided, you could default to a TypeScript one.
 	// if codeGen == nil {
@@ -58,28 +123,104 @@ func NewGeneratorWithOptions(outputDir string, opts GeneratorOptions, codeGen Co
 // Generate precomputes type names, delegates code generation to the CodeGenerator,
 // then writes the generated content to a file.
 func (g *Generator) Generate(schema *parser.PlutusSchema) error {
-	// Precompute unique type names for all definitions.
+	types, err := Resolve(schema)
+	if err != nil {
+		return err
+	}
+	files, err := g.generateFiles(schema, types, g.chooseNames(schema))
+	if err != nil {
+		return err
+	}
+	return g.writeFiles(files)
+}
+
+// chooseNames precomputes unique type names for all definitions.
+func (g *Generator) chooseNames(schema *parser.PlutusSchema) map[string]string {
+	refNames := make([]string, 0, len(schema.Definitions))
+	for refName := range schema.Definitions {
+		refNames = append(refNames, refName)
+	}
+	// Visit definitions in a fixed order so that name clashes resolve the same way every time.
+	sort.Strings(refNames)
 	usedNames := make(map[string]bool)
 	chosenNames := make(map[string]string)
-	for refName, def := range schema.Definitions {
-		title := def.Title
+	for _, refName := range refNames {
+		title := schema.Definitions[refName].Title
 		if title == "" {
 			title = refName
 		}
 		chosenNames[refName] = g.getUniqueTypeName(title, refName, usedNames)
 	}
+	return chosenNames
+}
 
-	code, err := g.CodeGen.Generate(schema, chosenNames)
+// generateFiles delegates code generation to the CodeGenerator and returns the generated
+// files keyed by name.
+func (g *Generator) generateFiles(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string) (map[string]string, error) {
+	if err := CheckWrappedRedeemers(types, g.Options); err != nil {
+		return nil, err
+	}
+	files, err := g.generateScriptContexts()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if fsGen, ok := g.CodeGen.(FileSetGenerator); ok {
+		generated, err := fsGen.GenerateFiles(schema, types, chosenNames, g.Options)
+		if err != nil {
+			return nil, err
+		}
+		for name, code := range generated {
+			files[name] = code
+		}
+		return files, nil
+	}
+	code, err := g.CodeGen.Generate(schema, types, chosenNames, g.Options)
+	if err != nil {
+		return nil, err
+	}
+	files[g.CodeGen.FileName()] = code
+	return files, nil
+}
+
+// Resolve returns the resolved definitions of schema, which the code generators work
+// from, after checking them and the schemas of the arguments of its validators.
+func Resolve(schema *parser.PlutusSchema) (*ir.Schema, error) {
+	types, err := ir.Build(schema.Definitions)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range schema.Validators {
+		names := []string{"datum", "redeemer"}
+		args := []*parser.PlutusArgument{v.Datum, v.Redeemer}
+		for i := range v.Parameters {
+			names = append(names, fmt.Sprintf("parameter %d", i+1))
+			args = append(args, &v.Parameters[i])
+		}
+		for i, arg := range args {
+			if arg == nil {
+				continue
+			}
+			if err := types.Validate(types.Convert(arg.Schema)); err != nil {
+				return nil, fmt.Errorf("validator %s: %s: %w", v.Title, names[i], err)
+			}
+		}
 	}
+	return types, nil
+}
 
+// writeFiles writes the generated files to the output directory.
+func (g *Generator) writeFiles(files map[string]string) error {
 	if err := os.MkdirAll(g.OutputDir, 0755); err != nil {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	filePath := filepath.Join(g.OutputDir, g.CodeGen.FileName())
-	return os.WriteFile(filePath, []byte(code), 0644)
+	for name, code := range files {
+		filePath := filepath.Join(g.OutputDir, name)
+		if err := os.WriteFile(filePath, []byte(code), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // getUniqueTypeName returns a type name that does not collide with existing names.
@@ -115,13 +256,39 @@ func (g *Generator) getUniqueTypeName(title, refName string, used map[string]boo
 	return unique
 }
 
+// IsInlinedCollectionRef reports whether a normalized reference names a list or
+// associative list instantiation (List$..., Pairs$...) whose schema is written inline
+// at each use site rather than referenced by name.
+func IsInlinedCollectionRef(ref string) bool {
+	return strings.HasPrefix(ref, "List$") || strings.HasPrefix(ref, "Pairs$")
+}
+
+// identifierSeparators matches the characters between the words of an identifier built
+// from a title or module path.
+var identifierSeparators = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// nonWordChars matches the characters a type name cannot hold.
+var nonWordChars = regexp.MustCompile(`[^\w]+`)
+
+// MakeValidatorName returns the identifier under which a validator is exported, e.g.
+// "MarketListingSpendValidator" for "market.listing.spend".
+func MakeValidatorName(title string) string {
+	var b strings.Builder
+	for _, part := range identifierSeparators.Split(title, -1) {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String() + "Validator"
+}
+
 // MakeTypeName cleans a raw name and returns a TypeScript/Go–friendly type name.
 func MakeTypeName(raw string) string {
 	raw = strings.ReplaceAll(raw, " ", "_")
 	raw = strings.ReplaceAll(raw, "$", "_")
 	// Replace all non-word characters with underscore.
-	re := regexp.MustCompile(`[^\w]+`)
-	raw = re.ReplaceAllString(raw, "_")
+	raw = nonWordChars.ReplaceAllString(raw, "_")
 	// Handle "~1" if present.
 	if strings.Contains(raw, "~1") {
 		parts := strings.Split(raw, "~1")
@@ -141,72 +308,79 @@ func MakeTypeName(raw string) string {
 // were originally only used for TypeScript generation. They’ve been moved here and exported
 // so that the TypeScript generator (and eventually others) can reuse them.
 
-// CollectDependenciesMemo returns all direct dependency reference names for the definition identified by refName.
-func CollectDependenciesMemo(refName string, defs map[string]parser.PlutusDefinition, memo map[string][]string) []string {
+// CollectDependenciesMemo returns the sorted keys of the definitions the definition
+// identified by refName depends on (see Dependencies), memoized in memo.
+func CollectDependenciesMemo(refName string, types *ir.Schema, memo map[string][]string) []string {
 	if deps, ok := memo[refName]; ok {
 		return deps
 	}
-	def := defs[refName]
+	deps := []string{}
+	if def, ok := types.Definitions[refName]; ok {
+		deps = Dependencies(def.Type, types)
+	}
+	memo[refName] = deps
+	return deps
+}
+
+// Dependencies returns the sorted keys of the definitions t refers to. List and map
+// instances that generators inline (see IsInlinedCollectionRef) are looked through
+// rather than returned.
+func Dependencies(t ir.Type, types *ir.Schema) []string {
+	deps, _ := dependencies(t, types)
+	return deps
+}
+
+// dependencies is Dependencies, also returning the sorted keys of the inlined list and
+// map instances it looked through.
+func dependencies(t ir.Type, types *ir.Schema) ([]string, []string) {
 	depsSet := make(map[string]bool)
-	var scan func(v interface{})
-	scan = func(v interface{}) {
-		switch v := v.(type) {
-		case parser.PlutusDefinition:
-			if v.Ref != "" {
-				r := strings.TrimPrefix(v.Ref, "#/definitions/")
-				r = strings.ReplaceAll(r, "~1", "/")
-				if strings.HasPrefix(r, "List$") {
-					if listDef, ok := defs[r]; ok {
-						if listDef.DataType == "map" {
-							scan(*listDef.Keys)
-							scan(*listDef.Values)
-						} else if listDef.Items != nil {
-							scan(*listDef.Items)
-						}
-					}
-				} else if _, exists := defs[r]; exists {
-					depsSet[r] = true
+	inlined := make(map[string]bool)
+	var scan func(t ir.Type)
+	scan = func(t ir.Type) {
+		switch t := t.(type) {
+		case *ir.Ref:
+			def, ok := types.Definitions[t.Key]
+			switch {
+			case !ok:
+			case IsInlinedCollectionRef(t.Key):
+				if !inlined[t.Key] {
+					inlined[t.Key] = true
+					scan(def.Type)
 				}
+			default:
+				depsSet[t.Key] = true
 			}
-			for _, alt := range v.AnyOf {
-				scan(alt)
-			}
-			for _, field := range v.Fields {
-				scan(field)
-			}
-			if v.Items != nil {
-				scan(*v.Items)
-			}
-			if v.Keys != nil {
-				scan(*v.Keys)
-			}
-			if v.Values != nil {
-				scan(*v.Values)
-			}
-		case parser.PlutusField:
-			if v.Ref != "" {
-				r := strings.TrimPrefix(v.Ref, "#/definitions/")
-				r = strings.ReplaceAll(r, "~1", "/")
-				if strings.HasPrefix(r, "List$") {
-					if listDef, ok := defs[r]; ok {
-						if listDef.Items != nil {
-							scan(*listDef.Items)
-						}
-					}
-				} else if _, exists := defs[r]; exists {
-					depsSet[r] = true
+		case *ir.Sum, *ir.Product:
+			for _, cons := range ir.Constructors(t) {
+				for _, f := range cons.Fields {
+					scan(f.Type)
 				}
 			}
-			if v.Items != nil {
-				scan(*v.Items)
+		case *ir.List:
+			scan(t.Items)
+		case *ir.Map:
+			scan(t.Keys)
+			scan(t.Values)
+		case *ir.Tuple:
+			for _, item := range t.Items {
+				scan(item)
 			}
+		case *ir.Pair:
+			scan(t.Left)
+			scan(t.Right)
 		}
 	}
-	scan(def)
-	deps := []string{}
-	for dep := range depsSet {
-		deps = append(deps, dep)
+	scan(t)
+	// Sort so that the definition order, and thus the generated output, is deterministic.
+	return sortedKeys(depsSet), sortedKeys(inlined)
+}
+
+// sortedKeys returns the keys of set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
 	}
-	memo[refName] = deps
-	return deps
+	sort.Strings(keys)
+	return keys
 }
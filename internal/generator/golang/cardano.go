@@ -0,0 +1,49 @@
+package golang
+
+import "github.com/mgpai22/gogenesis/internal/ir"
+
+// cardanoConstructor is the struct of generator.CardanoPackage holding a constructor of
+// a shared well-known type, with the names of its fields in order.
+type cardanoConstructor struct {
+	typeName string
+	fields   []string
+}
+
+// cardanoConstructors maps the canonical definitions of the shared well-known sum and
+// record types to the structs holding their constructors, in order. Value and POSIXTime,
+// which have no constructors, are handled on their own.
+var cardanoConstructors = map[string][]cardanoConstructor{
+	"cardano/address/Credential": {
+		{"cardano.VerificationKeyCredential", []string{"Hash"}},
+		{"cardano.ScriptCredential", []string{"Hash"}},
+	},
+	"cardano/address/StakeCredential": {
+		{"cardano.InlineCredential", []string{"Credential"}},
+		{"cardano.PointerCredential", []string{"SlotNumber", "TransactionIndex", "CertificateIndex"}},
+	},
+	"cardano/address/Address": {
+		{"cardano.Address", []string{"PaymentCredential", "StakeCredential"}},
+	},
+	"cardano/transaction/OutputReference": {
+		{"cardano.OutputReference", []string{"TransactionID", "OutputIndex"}},
+	},
+}
+
+// Canonical definitions of the shared well-known types without constructors.
+const (
+	cardanoValue     = "cardano/assets/Value"
+	cardanoPOSIXTime = "POSIXTime"
+)
+
+// resolveRefs follows references from t to the type of the definition they lead to. A
+// definition bound to a shared type may be an alias of another definition of its shape.
+func resolveRefs(t ir.Type, types *ir.Schema) ir.Type {
+	for seen := make(map[string]bool); ; {
+		ref, ok := t.(*ir.Ref)
+		if !ok || seen[ref.Key] {
+			return t
+		}
+		seen[ref.Key] = true
+		t = types.Definitions[ref.Key].Type
+	}
+}
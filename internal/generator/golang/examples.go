@@ -0,0 +1,573 @@
+package golang
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// exampleHelpers holds the main function and literal helpers appended to the generated
+// example program.
+//
+//go:embed examples.go.tmpl
+var exampleHelpers string
+
+// exampleFileName is the name of the generated example program.
+const exampleFileName = "plutus_examples.go"
+
+// exampleImports are the imports of the generated example program, all used by its helpers.
+var exampleImports = []string{
+	"encoding/hex",
+	"fmt",
+	"math/big",
+}
+
+// exampleFile accumulates the example values. It shares the names chosen for the
+// declarations of the types file through goFile.
+type exampleFile struct {
+	*goFile
+	// cardano records whether a literal of generator.CardanoPackage was written.
+	cardano bool
+}
+
+// generateExamples returns a program declaring each example of opts.Examples as a Go
+// literal of the generated types, and printing their CBOR encodings. Values are written
+// as the encode package reads them: constructors by title, byte strings in hex, maps as
+// arrays of [key, value] arrays and opaque data in the detailed JSON of cardano-cli.
+func generateExamples(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (string, error) {
+	f := newGoFile(types, chosenNames, opts)
+	// Emitting the declarations again records the names taken by types and constructors.
+	f.writeDefinitions()
+	f.body.Reset()
+	e := &exampleFile{goFile: f}
+
+	e.body.WriteString("// examples holds the example values, in the order they were given.\n")
+	e.body.WriteString("var examples = []Data{\n")
+	for i, example := range opts.Examples {
+		literal, err := e.refLiteral(example.Ref, example.Value, "$")
+		if err != nil {
+			return "", fmt.Errorf("example %d (%s): %w", i, example.Ref, err)
+		}
+		e.body.WriteString("\t" + e.encodeDefinition(example.Ref, literal) + ",\n")
+	}
+	e.body.WriteString("}\n\n")
+	e.body.WriteString(exampleHelpers)
+
+	var builder strings.Builder
+	imports := make(map[string]bool, len(exampleImports))
+	for _, path := range exampleImports {
+		imports[path] = true
+	}
+	if e.cardano {
+		imports[generator.CardanoPackage] = true
+	}
+	writeHeader(&builder, schema, imports)
+	builder.WriteString(e.body.String())
+	formatted, err := format.Source([]byte(builder.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated Go examples: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// refLiteral returns a Go literal of the type generated for the definition with key
+// refName, holding value. Errors name the path of the offending value, such as $.owner[2].
+func (e *exampleFile) refLiteral(refName string, value interface{}, path string) (string, error) {
+	def, ok := e.types.Definitions[refName]
+	if !ok {
+		return "", fmt.Errorf("%s: unknown definition %s", path, refName)
+	}
+	typeName := e.typeOf(&ir.Ref{Key: refName})
+
+	if t, args, ok := e.opts.WellKnown.Lookup(refName, e.types); ok && t.Golang != nil {
+		switch t.Builtin {
+		case generator.BuiltinBool:
+			return boolLiteral(value, path)
+		case generator.BuiltinOption:
+			constructors := ir.Constructors(def.Type)
+			i, fields, fieldsPath, err := chooseConstructor(constructors, value, path)
+			if err != nil {
+				return "", err
+			}
+			inner := &ir.Ref{Key: args[0]}
+			if len(constructors[i].Fields) == 0 {
+				return fmt.Sprintf("(*%s)(nil)", e.typeOf(inner)), nil
+			}
+			values, paths, err := constructorFields(constructors[i], fields, fieldsPath)
+			if err != nil {
+				return "", err
+			}
+			literal, err := e.refLiteral(args[0], values[0], paths[0])
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("examplePtr[%s](%s)", e.typeOf(inner), literal), nil
+		default:
+			if t.Shared == "" {
+				return "", fmt.Errorf("%s: %s is bound to the custom Go type %s", path, refName, t.Golang.Type)
+			}
+			return e.cardanoLiteral(t.Shared, def.Type, value, path)
+		}
+	}
+
+	if generator.IsWrappedRedeemer(refName, def.Type, e.opts) {
+		return e.structLiteral(typeName, ir.Constructors(def.Type)[0], []string{"Wrapped"}, value, path)
+	}
+	switch t := def.Type.(type) {
+	case *ir.Sum:
+		i, fields, path, err := chooseConstructor(t.Constructors, value, path)
+		if err != nil {
+			return "", err
+		}
+		cons := t.Constructors[i]
+		return e.structLiteral(e.consNames[typeName][i], cons, structFieldNames(cons), fields, path)
+	case *ir.Product:
+		return e.structLiteral(typeName, t.Constructor, structFieldNames(t.Constructor), value, path)
+	case *ir.Tuple:
+		return e.tupleLiteral(typeName, t.Items, value, path)
+	}
+	if e.recursive[refName] {
+		literal, err := e.literal(def.Type, value, path)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", typeName, literal), nil
+	}
+	return e.literal(def.Type, value, path)
+}
+
+// cardanoLiteral returns a literal of the type of generator.CardanoPackage that the
+// definition of type t is bound to, whose canonical definition is shared.
+func (e *exampleFile) cardanoLiteral(shared string, t ir.Type, value interface{}, path string) (string, error) {
+	e.cardano = true
+	t = resolveRefs(t, e.types)
+	switch shared {
+	case cardanoPOSIXTime:
+		n, err := parseInt(value, path)
+		if err != nil {
+			return "", err
+		}
+		if !n.IsInt64() {
+			return "", fmt.Errorf("%s: %s is out of the range of cardano.POSIXTime", path, n)
+		}
+		return fmt.Sprintf("cardano.POSIXTime(%s)", n), nil
+	case cardanoValue:
+		return e.valueLiteral(t.(*ir.Map), value, path)
+	}
+	structs := cardanoConstructors[shared]
+	if sum, ok := t.(*ir.Sum); ok {
+		i, fields, path, err := chooseConstructor(sum.Constructors, value, path)
+		if err != nil {
+			return "", err
+		}
+		return e.structLiteral(structs[i].typeName, sum.Constructors[i], structs[i].fields, fields, path)
+	}
+	return e.structLiteral(structs[0].typeName, ir.Constructors(t)[0], structs[0].fields, value, path)
+}
+
+// valueLiteral returns a cardano.Value literal. The Go map sorts its policies and asset
+// names when encoded, so the example must list them in ascending order for its encoding
+// to be the one written.
+func (e *exampleFile) valueLiteral(m *ir.Map, value interface{}, path string) (string, error) {
+	policies, err := sortedEntries(value, path)
+	if err != nil {
+		return "", err
+	}
+	inner := resolveRefs(m.Values, e.types).(*ir.Map)
+	literals := make([]string, len(policies))
+	for i, policy := range policies {
+		entryPath := fmt.Sprintf("%s[%d]", path, i)
+		assets, err := sortedEntries(policy[1], entryPath+"[1]")
+		if err != nil {
+			return "", err
+		}
+		quantities := make([]string, len(assets))
+		for j, asset := range assets {
+			quantity, err := e.literal(inner.Values, asset[1], fmt.Sprintf("%s[1][%d][1]", entryPath, j))
+			if err != nil {
+				return "", err
+			}
+			quantities[j] = fmt.Sprintf("%q: %s", asset[0], quantity)
+		}
+		literals[i] = fmt.Sprintf("%q: {%s}", policy[0], strings.Join(quantities, ", "))
+	}
+	return "cardano.Value{" + strings.Join(literals, ", ") + "}", nil
+}
+
+// sortedEntries returns the entries of a map keyed by byte strings, given as an array of
+// [key, value] arrays whose keys are lower-case hex strings in strictly ascending order.
+func sortedEntries(value interface{}, path string) ([][2]interface{}, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an array of [key, value] arrays, found %s", path, describe(value))
+	}
+	entries := make([][2]interface{}, len(items))
+	var previous []byte
+	for i, item := range items {
+		pair, ok := item.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("%s[%d]: expected a [key, value] array, found %s", path, i, describe(item))
+		}
+		key, ok := pair[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d][0]: expected a hex string, found %s", path, i, describe(pair[0]))
+		}
+		b, err := hex.DecodeString(key)
+		if err != nil || hex.EncodeToString(b) != key {
+			return nil, fmt.Errorf("%s[%d][0]: expected a lower-case hex string, found %q", path, i, key)
+		}
+		if i > 0 && bytes.Compare(previous, b) >= 0 {
+			return nil, fmt.Errorf("%s[%d][0]: cardano.Value encodes its keys in ascending order, found %q after %q", path, i, key, hex.EncodeToString(previous))
+		}
+		previous = b
+		entries[i] = [2]interface{}{key, pair[1]}
+	}
+	return entries, nil
+}
+
+// chooseConstructor returns the position of the constructor value names, together with
+// its fields and their path. Constructors without fields are written as their title,
+// others as an object holding the fields under the title.
+func chooseConstructor(constructors []*ir.Constructor, value interface{}, path string) (int, interface{}, string, error) {
+	titles := make([]string, len(constructors))
+	for i, c := range constructors {
+		titles[i] = c.Title
+	}
+	find := func(title string) int {
+		for i, t := range titles {
+			if t == title {
+				return i
+			}
+		}
+		return -1
+	}
+	switch v := value.(type) {
+	case bool:
+		if len(titles) == 2 && titles[0] == "False" && titles[1] == "True" {
+			if v {
+				return 1, nil, path, nil
+			}
+			return 0, nil, path, nil
+		}
+	case string:
+		if i := find(v); i >= 0 {
+			return i, nil, path, nil
+		}
+	case map[string]interface{}:
+		if len(v) == 1 {
+			for title, fields := range v {
+				if i := find(title); i >= 0 {
+					return i, fields, path + "." + title, nil
+				}
+			}
+		}
+	}
+	return 0, nil, "", fmt.Errorf("%s: expected one of the constructors %s, found %s", path, strings.Join(titles, ", "), describe(value))
+}
+
+// structLiteral returns a literal of the struct typeName holding the fields of cons in
+// the Go fields names.
+func (e *exampleFile) structLiteral(typeName string, cons *ir.Constructor, names []string, value interface{}, path string) (string, error) {
+	values, paths, err := constructorFields(cons, value, path)
+	if err != nil {
+		return "", err
+	}
+	fields := make([]string, len(cons.Fields))
+	for i, field := range cons.Fields {
+		literal, err := e.literal(field.Type, values[i], paths[i])
+		if err != nil {
+			return "", err
+		}
+		fields[i] = fmt.Sprintf("%s: %s", names[i], literal)
+	}
+	return typeName + "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+// constructorFields returns the values of the fields of cons, given as an object keyed by
+// field title or as an array, and their paths.
+func constructorFields(cons *ir.Constructor, value interface{}, path string) ([]interface{}, []string, error) {
+	values := make([]interface{}, len(cons.Fields))
+	paths := make([]string, len(cons.Fields))
+	switch v := value.(type) {
+	case nil:
+		if len(cons.Fields) > 0 {
+			return nil, nil, fmt.Errorf("%s: constructor %s takes %d fields", path, cons.Title, len(cons.Fields))
+		}
+	case []interface{}:
+		if len(v) != len(cons.Fields) {
+			return nil, nil, fmt.Errorf("%s: constructor %s takes %d fields, found %d", path, cons.Title, len(cons.Fields), len(v))
+		}
+		for i := range cons.Fields {
+			values[i], paths[i] = v[i], fmt.Sprintf("%s[%d]", path, i)
+		}
+	case map[string]interface{}:
+		if len(v) != len(cons.Fields) {
+			return nil, nil, fmt.Errorf("%s: constructor %s takes %d fields, found %d", path, cons.Title, len(cons.Fields), len(v))
+		}
+		for i, field := range cons.Fields {
+			fv, ok := v[field.Title]
+			if !ok {
+				return nil, nil, fmt.Errorf("%s: missing field %s", path, field.Title)
+			}
+			values[i], paths[i] = fv, path+"."+field.Title
+		}
+	default:
+		return nil, nil, fmt.Errorf("%s: expected the fields of constructor %s as an object or array, found %s", path, cons.Title, describe(value))
+	}
+	return values, paths, nil
+}
+
+// tupleLiteral returns a literal of the tuple struct typeName holding the items of value.
+func (e *exampleFile) tupleLiteral(typeName string, items []ir.Type, value interface{}, path string) (string, error) {
+	values, ok := value.([]interface{})
+	if !ok || len(values) != len(items) {
+		return "", fmt.Errorf("%s: expected a tuple of %d items, found %s", path, len(items), describe(value))
+	}
+	fields := make([]string, len(items))
+	for i, item := range items {
+		literal, err := e.literal(item, values[i], fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return "", err
+		}
+		fields[i] = fmt.Sprintf("Field%d: %s", i, literal)
+	}
+	return typeName + "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+// literal returns a literal of the Go type of t. Opaque values are written in the
+// detailed JSON of cardano-cli.
+func (e *exampleFile) literal(t ir.Type, value interface{}, path string) (string, error) {
+	switch t := t.(type) {
+	case *ir.Ref:
+		return e.refLiteral(t.Key, value, path)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return intLiteral(value, path)
+		case ir.Bytes:
+			return bytesLiteral(value, path)
+		case ir.String:
+			s, ok := value.(string)
+			if !ok {
+				return "", fmt.Errorf("%s: expected a string, found %s", path, describe(value))
+			}
+			return strconv.Quote(s), nil
+		case ir.Boolean:
+			return boolLiteral(value, path)
+		case ir.Unit:
+			if value != nil {
+				return "", fmt.Errorf("%s: expected null, found %s", path, describe(value))
+			}
+			return "struct{}{}", nil
+		}
+	case *ir.Tuple:
+		return e.tupleLiteral(e.typeOf(t), t.Items, value, path)
+	case *ir.Map:
+		return e.mapLiteral(t, value, path)
+	case *ir.List:
+		items, ok := value.([]interface{})
+		if !ok {
+			return "", fmt.Errorf("%s: expected an array, found %s", path, describe(value))
+		}
+		literals := make([]string, len(items))
+		for i, item := range items {
+			var err error
+			if literals[i], err = e.literal(t.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return "", err
+			}
+		}
+		return e.typeOf(t) + "{" + strings.Join(literals, ", ") + "}", nil
+	case *ir.Pair:
+		items, ok := value.([]interface{})
+		if !ok || len(items) != 2 {
+			return "", fmt.Errorf("%s: expected a [left, right] array, found %s", path, describe(value))
+		}
+		left, err := e.literal(t.Left, items[0], path+"[0]")
+		if err != nil {
+			return "", err
+		}
+		right, err := e.literal(t.Right, items[1], path+"[1]")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s{Key: %s, Value: %s}", e.typeOf(t), left, right), nil
+	case *ir.Sum, *ir.Product:
+		return "", fmt.Errorf("%s: inline alternatives have no generated Go type", path)
+	}
+	return dataLiteral(value, path)
+}
+
+// mapLiteral returns a literal of the entries of a map, given as an array of [key, value]
+// arrays.
+func (e *exampleFile) mapLiteral(m *ir.Map, value interface{}, path string) (string, error) {
+	entries, ok := value.([]interface{})
+	if !ok {
+		return "", fmt.Errorf("%s: expected an array of [key, value] arrays, found %s", path, describe(value))
+	}
+	literals := make([]string, len(entries))
+	for i, entry := range entries {
+		pair, ok := entry.([]interface{})
+		if !ok || len(pair) != 2 {
+			return "", fmt.Errorf("%s[%d]: expected a [key, value] array, found %s", path, i, describe(entry))
+		}
+		k, err := e.literal(m.Keys, pair[0], fmt.Sprintf("%s[%d][0]", path, i))
+		if err != nil {
+			return "", err
+		}
+		v, err := e.literal(m.Values, pair[1], fmt.Sprintf("%s[%d][1]", path, i))
+		if err != nil {
+			return "", err
+		}
+		literals[i] = fmt.Sprintf("{Key: %s, Value: %s}", k, v)
+	}
+	return e.typeOf(m) + "{" + strings.Join(literals, ", ") + "}", nil
+}
+
+// dataLiteral returns a literal of arbitrary data written in the detailed JSON of
+// cardano-cli, such as {"int": 1} or {"constructor": 0, "fields": [...]}.
+func dataLiteral(value interface{}, path string) (string, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("%s: expected Data as an object such as {\"int\": 1}, found %s", path, describe(value))
+	}
+	switch {
+	case len(object) == 1 && object["int"] != nil:
+		return intLiteral(object["int"], path+".int")
+	case len(object) == 1 && object["bytes"] != nil:
+		return bytesLiteral(object["bytes"], path+".bytes")
+	case len(object) == 1 && object["list"] != nil:
+		items, ok := object["list"].([]interface{})
+		if !ok {
+			return "", fmt.Errorf("%s.list: expected an array", path)
+		}
+		literals := make([]string, len(items))
+		for i, item := range items {
+			var err error
+			if literals[i], err = dataLiteral(item, fmt.Sprintf("%s.list[%d]", path, i)); err != nil {
+				return "", err
+			}
+		}
+		return "[]Data{" + strings.Join(literals, ", ") + "}", nil
+	case len(object) == 1 && object["map"] != nil:
+		entries, ok := object["map"].([]interface{})
+		if !ok {
+			return "", fmt.Errorf("%s.map: expected an array", path)
+		}
+		literals := make([]string, len(entries))
+		for i, entry := range entries {
+			kv, ok := entry.(map[string]interface{})
+			if !ok || len(kv) != 2 || kv["k"] == nil || kv["v"] == nil {
+				return "", fmt.Errorf("%s.map[%d]: expected an object with k and v", path, i)
+			}
+			k, err := dataLiteral(kv["k"], fmt.Sprintf("%s.map[%d].k", path, i))
+			if err != nil {
+				return "", err
+			}
+			v, err := dataLiteral(kv["v"], fmt.Sprintf("%s.map[%d].v", path, i))
+			if err != nil {
+				return "", err
+			}
+			literals[i] = fmt.Sprintf("{Key: %s, Value: %s}", k, v)
+		}
+		return "[]Pair[Data, Data]{" + strings.Join(literals, ", ") + "}", nil
+	case len(object) == 2 && object["constructor"] != nil && object["fields"] != nil:
+		index, ok := object["constructor"].(json.Number)
+		if !ok {
+			return "", fmt.Errorf("%s.constructor: expected a number", path)
+		}
+		if _, err := strconv.ParseUint(index.String(), 10, 64); err != nil {
+			return "", fmt.Errorf("%s.constructor: invalid constructor index %s", path, index)
+		}
+		fields, ok := object["fields"].([]interface{})
+		if !ok {
+			return "", fmt.Errorf("%s.fields: expected an array", path)
+		}
+		literals := make([]string, len(fields))
+		for i, field := range fields {
+			var err error
+			if literals[i], err = dataLiteral(field, fmt.Sprintf("%s.fields[%d]", path, i)); err != nil {
+				return "", err
+			}
+		}
+		return fmt.Sprintf("Constr{Index: %s, Fields: []Data{%s}}", index, strings.Join(literals, ", ")), nil
+	default:
+		return "", fmt.Errorf("%s: expected Data as one of {\"int\"}, {\"bytes\"}, {\"list\"}, {\"map\"} or {\"constructor\", \"fields\"}", path)
+	}
+}
+
+func intLiteral(value interface{}, path string) (string, error) {
+	n, err := parseInt(value, path)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("exampleInt(%q)", n.String()), nil
+}
+
+// parseInt reads an integer given as a JSON number or a decimal string.
+func parseInt(value interface{}, path string) (*big.Int, error) {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return nil, fmt.Errorf("%s: expected an integer, found %s", path, describe(value))
+	}
+	n, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid integer %q", path, text)
+	}
+	return n, nil
+}
+
+func bytesLiteral(value interface{}, path string) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a hex string, found %s", path, describe(value))
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return "", fmt.Errorf("%s: invalid hex string: %w", path, err)
+	}
+	return fmt.Sprintf("exampleBytes(%q)", s), nil
+}
+
+func boolLiteral(value interface{}, path string) (string, error) {
+	switch value {
+	case true, "True":
+		return "true", nil
+	case false, "False":
+		return "false", nil
+	}
+	return "", fmt.Errorf("%s: expected a boolean, found %s", path, describe(value))
+}
+
+// describe names the JSON type of value for error messages.
+func describe(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	case string:
+		return "a string"
+	case []interface{}:
+		return "an array"
+	case map[string]interface{}:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
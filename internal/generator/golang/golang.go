@@ -1,14 +1,24 @@
 package golang
 
 import (
+	_ "embed"
 	"fmt"
+	"go/format"
+	"go/token"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
 	"github.com/mgpai22/gogenesis/internal/parser"
 )
 
+// runtime holds the Plutus data support code appended to every generated file.
+//
+//go:embed runtime.go.tmpl
+var runtime string
+
 type GoGenerator struct{}
 
 func NewGoGenerator() *GoGenerator {
@@ -20,12 +30,203 @@ func (g *GoGenerator) FileName() string {
 }
 
 // Generate returns the generated Go code as a string.
-func (g *GoGenerator) Generate(schema *parser.PlutusSchema, chosenNames map[string]string) (string, error) {
+// Sum types become an interface implemented by one struct per constructor, records become
+// structs, and primitive, list and map definitions become type aliases. Structs implement
+// ToPlutusData and MarshalCBOR using the runtime appended to the file.
+func (g *GoGenerator) Generate(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (string, error) {
+	f := newGoFile(types, chosenNames, opts)
+	f.writeDefinitions()
+	for _, v := range schema.Validators {
+		f.writeValidator(generator.MakeValidatorName(v.Title), v, schema.PlutusVersion())
+	}
+	f.body.WriteString(runtime)
+
+	var builder strings.Builder
+	f.imports["fmt"] = true
+	f.imports["math/big"] = true
+	writeHeader(&builder, schema, f.imports)
+	builder.WriteString(f.body.String())
+	formatted, err := format.Source([]byte(builder.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated Go code: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// GenerateProjects returns a file per project declaring the project's validators, for a
+// package whose types are generated from shared. A validator whose name is used by more
+// than one project is prefixed with its project's name.
+func (g *GoGenerator) GenerateProjects(projects []generator.Project, shared *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	counts := make(map[string]int)
+	for _, project := range projects {
+		for _, v := range project.Schema.Validators {
+			counts[generator.MakeValidatorName(v.Title)]++
+		}
+	}
+	files := make(map[string]string, len(projects))
+	for _, project := range projects {
+		f := newGoFile(types, chosenNames, opts)
+		for _, v := range project.Schema.Validators {
+			name := generator.MakeValidatorName(v.Title)
+			if counts[name] > 1 {
+				name = generator.MakeTypeName(project.Name) + name
+			}
+			f.writeValidator(name, v, project.Schema.PlutusVersion())
+		}
+		var builder strings.Builder
+		writeHeader(&builder, project.Schema, f.imports)
+		builder.WriteString(f.body.String())
+		formatted, err := format.Source([]byte(builder.String()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to format generated Go code for project %s: %w", project.Name, err)
+		}
+		files[generator.ProjectFileStem(project.Name, "_")+"_validators.go"] = string(formatted)
+	}
+	return files, nil
+}
+
+// GenerateScriptContext returns a file declaring the script context types of a Plutus
+// version, such as plutus_context_v3.go. The types share the package of the blueprint's
+// types, so their names are prefixed with the version, as in V3ScriptContext, and the
+// file relies on the runtime generated with them.
+func (g *GoGenerator) GenerateScriptContext(version string, schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	prefix := strings.ToUpper(version)
+	prefixed := make(map[string]string, len(chosenNames))
+	for refName, name := range chosenNames {
+		prefixed[refName] = prefix + name
+	}
+	f := newGoFile(types, prefixed, opts)
+	f.writeDefinitions()
+	if strings.Contains(f.body.String(), "big.") {
+		f.imports["math/big"] = true
+	}
 	var builder strings.Builder
-	builder.WriteString("// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.\n")
-	builder.WriteString("// Re-generate this by running the code generator script.\n\n")
-	builder.WriteString("package main\n\n")
+	writeHeader(&builder, schema, f.imports)
+	builder.WriteString(f.body.String())
+	formatted, err := format.Source([]byte(builder.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated Go code: %w", err)
+	}
+	return map[string]string{"plutus_context_" + version + ".go": string(formatted)}, nil
+}
+
+// writeHeader writes the file header (see generator.FileHeader), package clause and
+// imports.
+func writeHeader(builder *strings.Builder, schema *parser.PlutusSchema, imports map[string]bool) {
+	for _, line := range generator.FileHeader(schema) {
+		builder.WriteString("// " + line + "\n")
+	}
+	builder.WriteString("\npackage main\n\n")
+	if len(imports) == 0 {
+		return
+	}
+	// The standard library comes first, then the other packages, as goimports groups them.
+	var std, others []string
+	for path := range imports {
+		if first, _, _ := strings.Cut(path, "/"); strings.Contains(first, ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	builder.WriteString("import (\n")
+	for _, path := range std {
+		builder.WriteString(fmt.Sprintf("\t%q\n", path))
+	}
+	if len(std) > 0 && len(others) > 0 {
+		builder.WriteString("\n")
+	}
+	for _, path := range others {
+		builder.WriteString(fmt.Sprintf("\t%q\n", path))
+	}
+	builder.WriteString(")\n\n")
+}
+
+// goFile accumulates the declarations and imports of a generated Go file.
+type goFile struct {
+	types       *ir.Schema
+	chosenNames map[string]string
+	opts        generator.GeneratorOptions
+	// recursive holds the definitions emitted as defined types rather than aliases.
+	recursive map[string]bool
+
+	body      strings.Builder
+	imports   map[string]bool
+	usedNames map[string]bool
+	// consNames holds the struct names of the constructors of each sum type, in order.
+	consNames map[string][]string
+}
+
+func newGoFile(types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) *goFile {
+	// The runtime declarations are taken whatever names the options reserve.
+	used := generator.ReservedNames("golang")
+	for _, name := range chosenNames {
+		used[name] = true
+	}
+	return &goFile{
+		types:       types,
+		chosenNames: chosenNames,
+		opts:        opts,
+		recursive:   recursiveAliases(types, opts),
+		imports:     make(map[string]bool),
+		usedNames:   used,
+		consNames:   make(map[string][]string),
+	}
+}
 
+// recursiveAliases returns the definitions that would be emitted as type aliases but
+// refer back to themselves through other aliases, such as a list of optional
+// lists of itself. Go rejects alias cycles, so these are emitted as defined types that
+// implement PlutusDataMarshaler. Aliases of well-known bindings are left alone: a cycle
+// through them always also passes through a generated alias.
+func recursiveAliases(types *ir.Schema, opts generator.GeneratorOptions) map[string]bool {
+	var aliases []string
+	bound := make(map[string]bool)
+	for refName, def := range types.Definitions {
+		if t, _, ok := opts.WellKnown.Lookup(refName, types); ok && t.Golang != nil {
+			aliases = append(aliases, refName)
+			bound[refName] = true
+			continue
+		}
+		if generator.IsWrappedRedeemer(refName, def.Type, opts) || !isAlias(def.Type) {
+			continue
+		}
+		aliases = append(aliases, refName)
+	}
+	sort.Strings(aliases)
+	memo := make(map[string][]string)
+	deps := func(refName string) []string {
+		return generator.CollectDependenciesMemo(refName, types, memo)
+	}
+	recursive := make(map[string]bool)
+	for _, component := range generator.Components(aliases, deps) {
+		if !generator.IsRecursive(component, deps) {
+			continue
+		}
+		for _, refName := range component {
+			if !bound[refName] {
+				recursive[refName] = true
+			}
+		}
+	}
+	return recursive
+}
+
+// isAlias reports whether a definition of type t is emitted as a type alias, rather than
+// as the structs of its constructors or items.
+func isAlias(t ir.Type) bool {
+	switch t.(type) {
+	case *ir.Sum, *ir.Product, *ir.Tuple:
+		return false
+	}
+	return true
+}
+
+// writeDefinitions emits the declarations of all definitions, each after the
+// definitions it depends on.
+func (f *goFile) writeDefinitions() {
 	// Order definitions topologically.
 	visited := make(map[string]bool)
 	var finalOrder []string
@@ -36,13 +237,13 @@ func (g *GoGenerator) Generate(schema *parser.PlutusSchema, chosenNames map[stri
 			return
 		}
 		visited[refName] = true
-		for _, dep := range generator.CollectDependenciesMemo(refName, schema.Definitions, depMemo) {
+		for _, dep := range generator.CollectDependenciesMemo(refName, f.types, depMemo) {
 			visit(dep)
 		}
 		finalOrder = append(finalOrder, refName)
 	}
 	var refNames []string
-	for refName := range schema.Definitions {
+	for refName := range f.types.Definitions {
 		refNames = append(refNames, refName)
 	}
 	sort.Strings(refNames)
@@ -50,33 +251,371 @@ func (g *GoGenerator) Generate(schema *parser.PlutusSchema, chosenNames map[stri
 		visit(refName)
 	}
 
-	// For each definition, generate a Go struct.
 	for _, refName := range finalOrder {
-		def := schema.Definitions[refName]
-		typeName := chosenNames[refName]
-		builder.WriteString(fmt.Sprintf("// Definition for %s\n", refName))
-		builder.WriteString(fmt.Sprintf("type %s struct {\n", typeName))
-		// For simplicity each field becomes an "interface{}" placeholder.
-		for _, field := range def.Fields {
-			fieldName := field.Title
-			if fieldName == "" {
-				fieldName = "Field"
-			}
-			// A simple mapping: if there is a reference, try to use the chosen type.
-			fieldType := "interface{}"
-			if field.Ref != "" {
-				r := strings.TrimPrefix(field.Ref, "#/definitions/")
-				r = strings.ReplaceAll(r, "~1", "")
-				if tn, ok := chosenNames[r]; ok {
-					fieldType = tn
-				} else {
-					fieldType = generator.MakeTypeName(r)
-				}
-			}
-			builder.WriteString(fmt.Sprintf("    %s %s `json:\"%s\"`\n", fieldName, fieldType, fieldName))
+		f.writeDefinition(refName)
+	}
+}
+
+// writeDefinition emits the Go declaration(s) for a single definition.
+func (f *goFile) writeDefinition(refName string) {
+	def := f.types.Definitions[refName]
+	typeName := f.chosenNames[refName]
+	f.body.WriteString(fmt.Sprintf("// Definition for %s\n", refName))
+	doc := def.Description
+	if p, ok := def.Type.(*ir.Product); ok && p.Constructor.Description != "" && p.Constructor.Description != doc {
+		// Records are documented on their only constructor as well.
+		doc = strings.TrimSpace(doc + "\n\n" + p.Constructor.Description)
+	}
+	f.writeDoc(doc)
+
+	if t, args, ok := f.opts.WellKnown.Lookup(refName, f.types); ok && t.Golang != nil {
+		argTypes := make([]string, len(args))
+		for i, arg := range args {
+			argTypes[i] = f.typeOf(&ir.Ref{Key: arg})
+		}
+		if t.Golang.Import != "" {
+			f.imports[t.Golang.Import] = true
+		}
+		f.body.WriteString(fmt.Sprintf("type %s = %s\n\n", typeName, generator.ExpandBinding(t.Golang.Type, argTypes)))
+		return
+	}
+
+	if generator.IsWrappedRedeemer(refName, def.Type, f.opts) {
+		cons := *ir.Constructors(def.Type)[0]
+		cons.Fields = append([]*ir.Field(nil), cons.Fields...)
+		wrapped := *cons.Fields[0]
+		wrapped.Title = "Wrapped"
+		cons.Fields[0] = &wrapped
+		f.writeStruct(typeName, &cons, "")
+		return
+	}
+	switch t := def.Type.(type) {
+	case *ir.Sum:
+		f.writeSum(typeName, t)
+	case *ir.Product:
+		f.writeStruct(typeName, t.Constructor, "")
+	case *ir.Tuple:
+		f.writeTuple(typeName, t.Items)
+	default:
+		if !f.recursive[refName] {
+			f.body.WriteString(fmt.Sprintf("type %s = %s\n\n", typeName, f.typeOf(t)))
+			return
+		}
+		f.body.WriteString(fmt.Sprintf("type %s %s\n\n", typeName, f.typeOf(t)))
+		f.body.WriteString("// ToPlutusData returns the Plutus data representation of v.\n")
+		f.body.WriteString(fmt.Sprintf("func (v %s) ToPlutusData() Data {\n\treturn %s\n}\n\n", typeName, f.encode(t, "v")))
+		f.body.WriteString("// MarshalCBOR returns the CBOR encoding of v as Plutus data.\n")
+		f.body.WriteString(fmt.Sprintf("func (v %s) MarshalCBOR() ([]byte, error) {\n\treturn EncodeData(v)\n}\n\n", typeName))
+	}
+}
+
+// writeSum emits an interface for a multi-constructor definition and one struct per constructor.
+func (f *goFile) writeSum(typeName string, sum *ir.Sum) {
+	marker := "is" + typeName
+	f.body.WriteString(fmt.Sprintf("type %s interface {\n\tPlutusDataMarshaler\n\t%s()\n}\n\n", typeName, marker))
+	for i, cons := range sum.Constructors {
+		title := cons.Title
+		if title == "" {
+			title = fmt.Sprintf("Constructor%d", i)
+		}
+		consName := f.uniqueName(typeName + goIdentifier(title))
+		f.consNames[typeName] = append(f.consNames[typeName], consName)
+		f.body.WriteString(fmt.Sprintf("// %s is the %s constructor of %s.\n", consName, title, typeName))
+		f.writeDoc(cons.Description)
+		f.writeStruct(consName, cons, marker)
+	}
+}
+
+// writeStruct emits a struct with one Go field per constructor field, together with its
+// Plutus data encoding as constructor cons.Index. A non-empty marker adds the method
+// tying the struct to its sum type interface.
+func (f *goFile) writeStruct(typeName string, cons *ir.Constructor, marker string) {
+	f.body.WriteString(fmt.Sprintf("type %s struct {\n", typeName))
+	fieldNames := structFieldNames(cons)
+	encoded := make([]string, 0, len(cons.Fields))
+	for i, field := range cons.Fields {
+		fieldName := fieldNames[i]
+		tag := field.Title
+		if tag == "" {
+			tag = fieldName
+		}
+		for _, line := range generator.GoDoc(field.Description) {
+			f.body.WriteString("\t" + line + "\n")
+		}
+		f.body.WriteString(fmt.Sprintf("\t%s %s `json:\"%s\"`\n", fieldName, f.typeOf(field.Type), tag))
+		encoded = append(encoded, f.encode(field.Type, "v."+fieldName))
+	}
+	f.body.WriteString("}\n\n")
+
+	if marker != "" {
+		f.body.WriteString(fmt.Sprintf("func (%s) %s() {}\n\n", typeName, marker))
+	}
+	f.body.WriteString("// ToPlutusData returns the Plutus data representation of v.\n")
+	f.body.WriteString(fmt.Sprintf("func (v %s) ToPlutusData() Data {\n", typeName))
+	if len(encoded) == 0 {
+		f.body.WriteString(fmt.Sprintf("\treturn Constr{Index: %d}\n}\n\n", cons.Index))
+	} else {
+		f.body.WriteString(fmt.Sprintf("\treturn Constr{Index: %d, Fields: []Data{\n", cons.Index))
+		for _, e := range encoded {
+			f.body.WriteString("\t\t" + e + ",\n")
+		}
+		f.body.WriteString("\t}}\n}\n\n")
+	}
+	f.body.WriteString("// MarshalCBOR returns the CBOR encoding of v as Plutus data.\n")
+	f.body.WriteString(fmt.Sprintf("func (v %s) MarshalCBOR() ([]byte, error) {\n\treturn EncodeData(v)\n}\n\n", typeName))
+}
+
+// structFieldNames returns the names of the Go fields holding the fields of cons.
+func structFieldNames(cons *ir.Constructor) []string {
+	names := make([]string, len(cons.Fields))
+	seen := make(map[string]bool)
+	for i, field := range cons.Fields {
+		name := goIdentifier(field.Title)
+		if name == "" || seen[name] {
+			name = fmt.Sprintf("Field%d", i)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// writeTuple emits a struct with one field per tuple item. Tuples are encoded on-chain as
+// a list of their items.
+func (f *goFile) writeTuple(typeName string, items []ir.Type) {
+	f.body.WriteString(fmt.Sprintf("type %s %s\n\n", typeName, f.tupleStruct(items)))
+	f.body.WriteString("// ToPlutusData returns the Plutus data representation of v.\n")
+	f.body.WriteString(fmt.Sprintf("func (v %s) ToPlutusData() Data {\n\treturn %s\n}\n\n", typeName, f.encodeTuple(items, "v")))
+	f.body.WriteString("// MarshalCBOR returns the CBOR encoding of v as Plutus data.\n")
+	f.body.WriteString(fmt.Sprintf("func (v %s) MarshalCBOR() ([]byte, error) {\n\treturn EncodeData(v)\n}\n\n", typeName))
+}
+
+// tupleStruct returns the struct type holding the items of a tuple as Field0, Field1, ...
+func (f *goFile) tupleStruct(items []ir.Type) string {
+	fields := make([]string, len(items))
+	for i, item := range items {
+		fields[i] = fmt.Sprintf("Field%d %s", i, f.typeOf(item))
+	}
+	return "struct {\n" + strings.Join(fields, "\n") + "\n}"
+}
+
+// encodeTuple returns an expression converting the tuple struct value into a Data list.
+func (f *goFile) encodeTuple(items []ir.Type, value string) string {
+	encoded := make([]string, len(items))
+	for i, item := range items {
+		encoded[i] = f.encode(item, fmt.Sprintf("%s.Field%d", value, i))
+	}
+	return "[]Data{" + strings.Join(encoded, ", ") + "}"
+}
+
+// writeValidator emits a Validator variable describing a compiled validator.
+func (f *goFile) writeValidator(name string, v parser.PlutusValidator, plutusVersion string) {
+	f.body.WriteString(fmt.Sprintf("// %s is validator %s.\n", name, v.Title))
+	var types []string
+	if v.Datum != nil {
+		types = append(types, "Datum: "+f.typeOf(f.types.Convert(v.Datum.Schema))+".")
+	}
+	if v.Redeemer != nil {
+		types = append(types, "Redeemer: "+f.typeOf(f.types.Convert(v.Redeemer.Schema))+".")
+	}
+	if len(v.Parameters) > 0 {
+		params := make([]string, len(v.Parameters))
+		for i, p := range v.Parameters {
+			params[i] = f.typeOf(f.types.Convert(p.Schema))
+		}
+		types = append(types, "Parameters: "+strings.Join(params, ", ")+".")
+	}
+	if len(types) > 0 {
+		f.body.WriteString("// " + strings.Join(types, " ") + "\n")
+	}
+	f.writeDoc(generator.ValidatorDoc(v))
+	f.body.WriteString(fmt.Sprintf("var %s = Validator{\n", name))
+	f.body.WriteString(fmt.Sprintf("\tTitle: %q,\n", v.Title))
+	if purpose := v.Purpose(); purpose != "" {
+		f.body.WriteString(fmt.Sprintf("\tPurpose: %q,\n", purpose))
+	}
+	f.body.WriteString(fmt.Sprintf("\tPlutusVersion: %q,\n", plutusVersion))
+	f.body.WriteString(fmt.Sprintf("\tCompiledCode: %q,\n", v.CompiledCode))
+	f.body.WriteString(fmt.Sprintf("\tHash: %q,\n", v.Hash))
+	if info := generator.BakedScriptInfo(v, plutusVersion); info != nil {
+		if v.Purpose() == "mint" {
+			f.body.WriteString(fmt.Sprintf("\tPolicyID: %q,\n", info.Hash))
+		}
+		f.body.WriteString("\tAddresses: map[string]string{\n")
+		for _, a := range info.Addresses {
+			f.body.WriteString(fmt.Sprintf("\t\t%q: %q,\n", a.Network, a.Address))
+		}
+		f.body.WriteString("\t},\n")
+	}
+	f.body.WriteString("}\n\n")
+	if len(v.Parameters) > 0 {
+		f.writeApplyParams(name, v)
+	}
+}
+
+// uplcPackage is the package applying parameters to compiled validators.
+const uplcPackage = "github.com/mgpai22/gogenesis/uplc"
+
+// writeApplyParams emits a function applying the typed parameters of the validator
+// variable name to its compiled code.
+func (f *goFile) writeApplyParams(name string, v parser.PlutusValidator) {
+	funcName := "Apply" + strings.TrimSuffix(name, "Validator") + "Params"
+	used := map[string]bool{"v": true, "params": true, "err": true}
+	args := make([]string, len(v.Parameters))
+	encoded := make([]string, len(v.Parameters))
+	for i, p := range v.Parameters {
+		arg := goIdentifier(p.Title)
+		if arg != "" {
+			arg = strings.ToLower(arg[:1]) + arg[1:]
+		}
+		if arg == "" || used[arg] || token.IsKeyword(arg) {
+			arg = fmt.Sprintf("param%d", i+1)
 		}
-		builder.WriteString("}\n\n")
+		used[arg] = true
+		schema := f.types.Convert(p.Schema)
+		typ := f.typeOf(schema)
+		if strings.Contains(typ, "big.") {
+			f.imports["math/big"] = true
+		}
+		args[i] = arg + " " + typ
+		encoded[i] = f.encode(schema, arg)
 	}
+	f.imports[uplcPackage] = true
+	f.body.WriteString(fmt.Sprintf("// %s applies %s to its parameters. The result holds\n// the compiled code and hash of the applied script.\n", funcName, name))
+	f.body.WriteString(fmt.Sprintf("func %s(%s) (Validator, error) {\n", funcName, strings.Join(args, ", ")))
+	f.body.WriteString(fmt.Sprintf("\tv := %s\n", name))
+	f.body.WriteString(fmt.Sprintf("\tparams, err := encodeParams(%s)\n", strings.Join(encoded, ", ")))
+	f.body.WriteString("\tif err != nil {\n\t\treturn Validator{}, err\n\t}\n")
+	f.body.WriteString("\tif v.CompiledCode, v.Hash, err = uplc.ApplyParamsCBOR(v.CompiledCode, v.PlutusVersion, params...); err != nil {\n\t\treturn Validator{}, err\n\t}\n")
+	f.body.WriteString("\treturn v, nil\n}\n\n")
+}
 
-	return builder.String(), nil
+// writeDoc continues the comment just written with text as a paragraph of its own.
+func (f *goFile) writeDoc(text string) {
+	lines := generator.GoDoc(text)
+	if len(lines) == 0 {
+		return
+	}
+	f.body.WriteString("//\n")
+	for _, line := range lines {
+		f.body.WriteString(line + "\n")
+	}
+}
+
+// encode returns an expression converting value, of the Go type of t, into Data.
+func (f *goFile) encode(t ir.Type, value string) string {
+	switch t := t.(type) {
+	case *ir.Ref:
+		return f.encodeDefinition(t.Key, value)
+	case *ir.List:
+		return fmt.Sprintf("encodeList(%s, func(x %s) Data { return %s })", value, f.typeOf(t.Items), f.encode(t.Items, "x"))
+	case *ir.Map:
+		return fmt.Sprintf("encodeMap(%s, func(k %s) Data { return %s }, func(x %s) Data { return %s })",
+			value, f.typeOf(t.Keys), f.encode(t.Keys, "k"), f.typeOf(t.Values), f.encode(t.Values, "x"))
+	case *ir.Tuple:
+		return f.encodeTuple(t.Items, value)
+	case *ir.Pair:
+		return fmt.Sprintf("encodePair(%s, func(l %s) Data { return %s }, func(r %s) Data { return %s })",
+			value, f.typeOf(t.Left), f.encode(t.Left, "l"), f.typeOf(t.Right), f.encode(t.Right, "r"))
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Boolean:
+			return fmt.Sprintf("encodeBool(%s)", value)
+		case ir.Unit:
+			return "Constr{Index: 0}"
+		case ir.String:
+			return fmt.Sprintf("[]byte(%s)", value)
+		}
+	}
+	// Integers, byte strings and opaque data already are Data.
+	return value
+}
+
+// encodeDefinition returns an expression converting value, whose type is the definition
+// with the given key, into Data.
+func (f *goFile) encodeDefinition(key, value string) string {
+	def, ok := f.types.Definitions[key]
+	if !ok {
+		return value
+	}
+	if t, args, ok := f.opts.WellKnown.Lookup(key, f.types); ok && t.Golang != nil {
+		switch t.Builtin {
+		case generator.BuiltinBool:
+			return fmt.Sprintf("encodeBool(%s)", value)
+		case generator.BuiltinOption:
+			return fmt.Sprintf("encodeOption(%s, func(x %s) Data { return %s })", value, f.typeOf(&ir.Ref{Key: args[0]}), f.encodeDefinition(args[0], "x"))
+		default:
+			// Bound types encode themselves.
+			return value
+		}
+	}
+	if !isAlias(def.Type) || f.recursive[key] {
+		// Generated structs, sum types and recursive types implement PlutusDataMarshaler.
+		return value
+	}
+	return f.encode(def.Type, value)
+}
+
+// typeOf returns the Go type of t.
+func (f *goFile) typeOf(t ir.Type) string {
+	switch t := t.(type) {
+	case *ir.Ref:
+		if tn, ok := f.chosenNames[t.Key]; ok {
+			return tn
+		}
+		return generator.MakeTypeName(t.Key)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return "*big.Int"
+		case ir.Boolean:
+			return "bool"
+		case ir.Unit:
+			return "struct{}"
+		case ir.String:
+			return "string"
+		default:
+			return "[]byte"
+		}
+	case *ir.List:
+		return "[]" + f.typeOf(t.Items)
+	case *ir.Map:
+		return fmt.Sprintf("[]Pair[%s, %s]", f.typeOf(t.Keys), f.typeOf(t.Values))
+	case *ir.Tuple:
+		return f.tupleStruct(t.Items)
+	case *ir.Pair:
+		return fmt.Sprintf("Pair[%s, %s]", f.typeOf(t.Left), f.typeOf(t.Right))
+	default:
+		// Inline constructors are not generated; their values are opaque data.
+		return "Data"
+	}
+}
+
+// uniqueName returns name, or name with a numeric suffix if it is already taken.
+func (f *goFile) uniqueName(name string) string {
+	unique := name
+	for counter := 1; f.usedNames[unique]; counter++ {
+		unique = fmt.Sprintf("%s_%d", name, counter)
+	}
+	f.usedNames[unique] = true
+	return unique
+}
+
+var identifierSplit = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// goIdentifier converts a blueprint title such as "payment_credential" into an exported
+// Go identifier such as "PaymentCredential".
+func goIdentifier(title string) string {
+	var b strings.Builder
+	for _, part := range identifierSplit.Split(title, -1) {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	id := b.String()
+	if id != "" && id[0] >= '0' && id[0] <= '9' {
+		id = "X" + id
+	}
+	return id
 }
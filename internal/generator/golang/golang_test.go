@@ -0,0 +1,205 @@
+package golang
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/cardano"
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/internal/version"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestMain pins the version written in file headers, which release builds stamp through
+// -ldflags, to the one in the golden files.
+func TestMain(m *testing.M) {
+	version.Version, version.CommitHash = "", ""
+	os.Exit(m.Run())
+}
+
+func TestGenerateGolden(t *testing.T) {
+	blueprints, err := filepath.Glob("../../../testdata/blueprints/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range blueprints {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		t.Run(name, func(t *testing.T) {
+			schema, err := parser.ParsePlutusJSON(path)
+			if err != nil {
+				t.Fatalf("failed to parse blueprint: %v", err)
+			}
+			out := t.TempDir()
+			opts := generator.GeneratorOptions{
+				Language:  "golang",
+				WellKnown: generator.DefaultWellKnownRegistry(),
+			}
+			g := generator.NewGeneratorWithOptions(out, opts, NewGoGenerator())
+			if err := g.Generate(schema); err != nil {
+				t.Fatalf("generation failed: %v", err)
+			}
+			compareGolden(t, out, filepath.Join("testdata", name))
+		})
+	}
+}
+
+// mergedBlueprints are generated together by TestGenerateProjectsGolden.
+var mergedBlueprints = []string{"v3_market", "shared_escrow", "combinators"}
+
+func TestGenerateProjectsGolden(t *testing.T) {
+	var projects []generator.Project
+	for _, name := range mergedBlueprints {
+		schema, err := parser.ParsePlutusJSON(filepath.Join("../../../testdata/blueprints", name+".json"))
+		if err != nil {
+			t.Fatalf("failed to parse blueprint %s: %v", name, err)
+		}
+		projects = append(projects, generator.Project{Name: name, Schema: schema})
+	}
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:  "golang",
+		WellKnown: generator.DefaultWellKnownRegistry(),
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewGoGenerator())
+	if err := g.GenerateProjects(projects); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "merged"))
+}
+
+func TestGenerateScriptContextGolden(t *testing.T) {
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:       "golang",
+		WellKnown:      generator.DefaultWellKnownRegistry(),
+		ScriptContexts: generator.ScriptContextVersions,
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewGoGenerator())
+	if err := g.Generate(&parser.PlutusSchema{}); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "script_context"))
+}
+
+// propertyTestBlueprints are generated with property tests by
+// TestGeneratePropertyTestsGolden.
+var propertyTestBlueprints = []string{"recursive", "v3_market"}
+
+func TestGeneratePropertyTestsGolden(t *testing.T) {
+	for _, name := range propertyTestBlueprints {
+		t.Run(name, func(t *testing.T) {
+			schema, err := parser.ParsePlutusJSON(filepath.Join("../../../testdata/blueprints", name+".json"))
+			if err != nil {
+				t.Fatalf("failed to parse blueprint: %v", err)
+			}
+			out := t.TempDir()
+			opts := generator.GeneratorOptions{
+				Language:      "golang",
+				WellKnown:     generator.DefaultWellKnownRegistry(),
+				PropertyTests: true,
+			}
+			g := generator.NewGeneratorWithOptions(out, opts, NewGoGenerator())
+			if err := g.Generate(schema); err != nil {
+				t.Fatalf("generation failed: %v", err)
+			}
+			compareGolden(t, out, filepath.Join("testdata", "property_tests", name))
+		})
+	}
+}
+
+// compareGolden checks that every file generated into dir matches the file of the same
+// name in goldenDir, rewriting goldenDir instead when -update is set.
+func compareGolden(t *testing.T, dir, goldenDir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, entry := range entries {
+		got, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		goldenPath := filepath.Join(goldenDir, entry.Name())
+		if *update {
+			if err := os.WriteFile(goldenPath, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("missing golden file (run with -update): %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s differs from %s (run with -update to accept)", entry.Name(), goldenPath)
+		}
+	}
+	goldens, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(goldens) != len(entries) {
+		t.Errorf("generated %d files, golden directory holds %d", len(entries), len(goldens))
+	}
+}
+
+// TestCardanoConstructors checks that the structs holding the constructors of the shared
+// well-known types exist in the cardano package, with one field per field of the
+// canonical constructor, named as literals name them.
+func TestCardanoConstructors(t *testing.T) {
+	structs := map[string]reflect.Type{
+		"cardano.VerificationKeyCredential": reflect.TypeOf(cardano.VerificationKeyCredential{}),
+		"cardano.ScriptCredential":          reflect.TypeOf(cardano.ScriptCredential{}),
+		"cardano.InlineCredential":          reflect.TypeOf(cardano.InlineCredential{}),
+		"cardano.PointerCredential":         reflect.TypeOf(cardano.PointerCredential{}),
+		"cardano.Address":                   reflect.TypeOf(cardano.Address{}),
+		"cardano.OutputReference":           reflect.TypeOf(cardano.OutputReference{}),
+	}
+	types, err := ir.Build(generator.WellKnownDefinitions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for canonical, constructors := range cardanoConstructors {
+		def, ok := types.Definitions[canonical]
+		if !ok {
+			t.Errorf("%s: no canonical definition", canonical)
+			continue
+		}
+		if len(ir.Constructors(def.Type)) != len(constructors) {
+			t.Errorf("%s: %d structs for %d constructors", canonical, len(constructors), len(ir.Constructors(def.Type)))
+			continue
+		}
+		for i, cons := range ir.Constructors(def.Type) {
+			s, ok := structs[constructors[i].typeName]
+			if !ok {
+				t.Errorf("%s: unknown struct %s", canonical, constructors[i].typeName)
+				continue
+			}
+			if s.NumField() != len(cons.Fields) || len(constructors[i].fields) != len(cons.Fields) {
+				t.Errorf("%s: %s has %d fields, want %d", canonical, s, s.NumField(), len(cons.Fields))
+				continue
+			}
+			for j, name := range constructors[i].fields {
+				if s.Field(j).Name != name {
+					t.Errorf("%s: field %d of %s is %s, want %s", canonical, j, s, s.Field(j).Name, name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,437 @@
+package golang
+
+import (
+	_ "embed"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// propertyHelpers holds the random value helpers and the schema checker appended to the
+// generated test file.
+//
+//go:embed property_test.go.tmpl
+var propertyHelpers string
+
+// propertyTestFileName is the name of the generated roundtrip test file.
+const propertyTestFileName = "plutus_types_test.go"
+
+// propertyImports are the imports of the generated test file, all used by its helpers.
+var propertyImports = []string{
+	"bytes",
+	"fmt",
+	"math/big",
+	"math/rand",
+	"testing",
+	"unicode/utf8",
+	"github.com/mgpai22/gogenesis/plutusdata",
+}
+
+// GenerateFiles returns the generated types and, when opts.PropertyTests is set, a test
+// file checking that random values of every type encode to CBOR that decodes back to
+// Plutus data of the shape the blueprint describes. When opts.Examples is set, it adds a
+// program printing the encodings of the examples.
+func (g *GoGenerator) GenerateFiles(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	code, err := g.Generate(schema, types, chosenNames, opts)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{g.FileName(): code}
+	if opts.PropertyTests {
+		test, err := generatePropertyTests(schema, types, chosenNames, opts)
+		if err != nil {
+			return nil, err
+		}
+		files[propertyTestFileName] = test
+	}
+	if len(opts.Examples) > 0 {
+		program, err := generateExamples(schema, types, chosenNames, opts)
+		if err != nil {
+			return nil, err
+		}
+		files[exampleFileName] = program
+	}
+	return files, nil
+}
+
+// propertyFile accumulates the generators of the test file. It shares the names chosen
+// for the declarations of the types file through goFile.
+type propertyFile struct {
+	*goFile
+	// genNames maps each definition with a generator to the generator's name.
+	genNames map[string]string
+	// cardano records whether a value of generator.CardanoPackage was written.
+	cardano bool
+}
+
+// generatePropertyTests returns a test file declaring a GenX(r, depth) function returning
+// a random value of each generated type X, honouring constructors, list bounds, unique
+// items and distinct map keys, and a table-driven test encoding such values to CBOR,
+// decoding them with the plutusdata package and checking them against the blueprint.
+// Definitions bound to custom Go types other than the shared ones, and those depending on
+// them, are skipped.
+func generatePropertyTests(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (string, error) {
+	f := newGoFile(types, chosenNames, opts)
+	// Emitting the declarations again records the names taken by types and constructors.
+	f.writeDefinitions()
+	f.body.Reset()
+	p := &propertyFile{goFile: f, genNames: make(map[string]string)}
+
+	var refNames []string
+	for refName := range f.types.Definitions {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	unsupported := p.unsupportedDefinitions(refNames)
+	for _, refName := range refNames {
+		if !unsupported[refName] {
+			p.genNames[refName] = f.uniqueName("Gen" + f.chosenNames[refName])
+		}
+	}
+	for _, refName := range refNames {
+		if _, ok := p.genNames[refName]; ok {
+			p.writeGenerator(refName)
+		}
+	}
+	p.writeRoundtripTest(refNames)
+	p.writeSchemas(refNames)
+	f.body.WriteString(propertyHelpers)
+
+	var builder strings.Builder
+	imports := make(map[string]bool, len(propertyImports))
+	for _, path := range propertyImports {
+		imports[path] = true
+	}
+	if p.cardano {
+		imports[generator.CardanoPackage] = true
+	}
+	writeHeader(&builder, schema, imports)
+	builder.WriteString(f.body.String())
+	formatted, err := format.Source([]byte(builder.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated Go tests: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// unsupportedDefinitions returns the definitions no generator can be emitted for: those
+// bound to custom Go types other than the shared ones, whose values cannot be built from
+// the blueprint alone, and those depending on them.
+func (p *propertyFile) unsupportedDefinitions(refNames []string) map[string]bool {
+	unsupported := make(map[string]bool)
+	memo := make(map[string][]string)
+	for _, refName := range refNames {
+		if t, _, ok := p.opts.WellKnown.Lookup(refName, p.types); ok && t.Golang != nil && t.Builtin == "" && t.Shared == "" {
+			unsupported[refName] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, refName := range refNames {
+			if unsupported[refName] {
+				continue
+			}
+			for _, dep := range generator.CollectDependenciesMemo(refName, p.types, memo) {
+				if unsupported[dep] {
+					unsupported[refName] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return unsupported
+}
+
+// writeGenerator emits the generator of a definition.
+func (p *propertyFile) writeGenerator(refName string) {
+	def := p.types.Definitions[refName]
+	typeName := p.chosenNames[refName]
+	genName := p.genNames[refName]
+	p.body.WriteString(fmt.Sprintf("// %s returns a random %s.\n", genName, typeName))
+	p.body.WriteString(fmt.Sprintf("func %s(r *rand.Rand, depth int) %s {\n", genName, typeName))
+	defer p.body.WriteString("}\n\n")
+
+	if t, args, ok := p.opts.WellKnown.Lookup(refName, p.types); ok && t.Golang != nil {
+		switch t.Builtin {
+		case generator.BuiltinBool:
+			p.body.WriteString("\treturn r.Intn(2) == 1\n")
+		case generator.BuiltinOption:
+			p.body.WriteString(fmt.Sprintf("\treturn genOption(r, depth-1, %s)\n", p.genNames[args[0]]))
+		default:
+			p.writeCardanoGenerator(refName, t.Shared)
+		}
+		return
+	}
+
+	if generator.IsWrappedRedeemer(refName, def.Type, p.opts) {
+		field := ir.Constructors(def.Type)[0].Fields[0]
+		p.body.WriteString(fmt.Sprintf("\treturn %s{Wrapped: %s}\n", typeName, p.gen(field.Type, "depth-1")))
+		return
+	}
+	switch t := def.Type.(type) {
+	case *ir.Sum:
+		consNames := p.consNames[typeName]
+		p.writeChoice(refName, t, func(i int) string {
+			return p.genStruct(consNames[i], t.Constructors[i], structFieldNames(t.Constructors[i]))
+		})
+	case *ir.Product:
+		p.body.WriteString(fmt.Sprintf("\treturn %s\n", p.genStruct(typeName, t.Constructor, structFieldNames(t.Constructor))))
+	case *ir.Tuple:
+		p.body.WriteString(fmt.Sprintf("\treturn %s\n", p.genTuple(typeName, t.Items)))
+	default:
+		if p.recursive[refName] {
+			p.body.WriteString(fmt.Sprintf("\treturn %s(%s)\n", typeName, p.gen(t, "depth")))
+			return
+		}
+		p.body.WriteString(fmt.Sprintf("\treturn %s\n", p.gen(t, "depth")))
+	}
+}
+
+// writeCardanoGenerator emits the body of the generator of a definition bound to the
+// type of generator.CardanoPackage standing for the canonical definition shared.
+func (p *propertyFile) writeCardanoGenerator(refName, shared string) {
+	p.cardano = true
+	t := resolveRefs(p.types.Definitions[refName].Type, p.types)
+	switch shared {
+	case cardanoPOSIXTime:
+		p.body.WriteString("\treturn cardano.POSIXTime(r.Int63() - r.Int63())\n")
+		return
+	case cardanoValue:
+		p.body.WriteString("\tv := cardano.Value{}\n")
+		p.body.WriteString("\tfor i := genLength(r, depth-1, 0, 0); i > 0; i-- {\n")
+		p.body.WriteString("\t\tassets := map[string]*big.Int{}\n")
+		p.body.WriteString("\t\tfor j := genLength(r, depth-1, 0, 0); j > 0; j-- {\n")
+		p.body.WriteString("\t\t\tassets[fmt.Sprintf(\"%x\", genBytes(r))] = genInteger(r)\n")
+		p.body.WriteString("\t\t}\n")
+		p.body.WriteString("\t\tv[fmt.Sprintf(\"%x\", genBytes(r))] = assets\n")
+		p.body.WriteString("\t}\n")
+		p.body.WriteString("\treturn v\n")
+		return
+	}
+	structs := cardanoConstructors[shared]
+	if sum, ok := t.(*ir.Sum); ok {
+		p.writeChoice(refName, sum, func(i int) string {
+			return p.genStruct(structs[i].typeName, sum.Constructors[i], structs[i].fields)
+		})
+		return
+	}
+	p.body.WriteString(fmt.Sprintf("\treturn %s\n", p.genStruct(structs[0].typeName, ir.Constructors(t)[0], structs[0].fields)))
+}
+
+// writeChoice emits a switch returning one of the constructors of a sum type, built by
+// constructor. Once depth reaches zero, recursive types choose among the constructors
+// that do not lead back to the type.
+func (p *propertyFile) writeChoice(refName string, sum *ir.Sum, constructor func(i int) string) {
+	args := []string{"r", "depth", fmt.Sprint(len(sum.Constructors))}
+	if base := generator.BaseConstructors(refName, p.types); len(base) > 0 && len(base) < len(sum.Constructors) {
+		for _, i := range base {
+			args = append(args, fmt.Sprint(i))
+		}
+	}
+	p.body.WriteString(fmt.Sprintf("\tswitch genChoice(%s) {\n", strings.Join(args, ", ")))
+	for i := range sum.Constructors {
+		if i == len(sum.Constructors)-1 {
+			p.body.WriteString("\tdefault:\n")
+		} else {
+			p.body.WriteString(fmt.Sprintf("\tcase %d:\n", i))
+		}
+		p.body.WriteString(fmt.Sprintf("\t\treturn %s\n", constructor(i)))
+	}
+	p.body.WriteString("\t}\n")
+}
+
+// genStruct returns a literal of the struct typeName holding random fields of cons,
+// named names.
+func (p *propertyFile) genStruct(typeName string, cons *ir.Constructor, names []string) string {
+	fields := make([]string, len(cons.Fields))
+	for i, field := range cons.Fields {
+		fields[i] = fmt.Sprintf("%s: %s", names[i], p.gen(field.Type, "depth-1"))
+	}
+	return typeName + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// genTuple returns a literal of the tuple struct typeName holding random items.
+func (p *propertyFile) genTuple(typeName string, items []ir.Type) string {
+	fields := make([]string, len(items))
+	for i, item := range items {
+		fields[i] = fmt.Sprintf("Field%d: %s", i, p.gen(item, "depth-1"))
+	}
+	return typeName + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// genFunc returns a function value generating values of type t.
+func (p *propertyFile) genFunc(t ir.Type) string {
+	if ref, ok := t.(*ir.Ref); ok {
+		return p.genNames[ref.Key]
+	}
+	expr := p.gen(t, "depth")
+	if expr == "genData(r, depth)" {
+		return "genData"
+	}
+	return fmt.Sprintf("func(r *rand.Rand, depth int) %s {\n\treturn %s\n}", p.typeOf(t), expr)
+}
+
+// gen returns an expression building a random value of type t, where depth is the
+// expression holding the remaining depth.
+func (p *propertyFile) gen(t ir.Type, depth string) string {
+	nested := deeper(depth)
+	switch t := t.(type) {
+	case *ir.Ref:
+		return fmt.Sprintf("%s(r, %s)", p.genNames[t.Key], depth)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return "genInteger(r)"
+		case ir.Bytes:
+			return "genBytes(r)"
+		case ir.Boolean:
+			return "r.Intn(2) == 1"
+		case ir.Unit:
+			return "struct{}{}"
+		case ir.String:
+			return "genString(r)"
+		}
+	case *ir.Tuple:
+		return p.genTuple(p.typeOf(t), t.Items)
+	case *ir.List:
+		if _, ok := t.Items.(*ir.Opaque); !ok && t.UniqueItems {
+			return fmt.Sprintf("genUniqueList(r, %s, %d, %d, %s, func(x %s) Data { return %s })",
+				nested, t.MinItems, t.MaxItems, p.genFunc(t.Items), p.typeOf(t.Items), p.encode(t.Items, "x"))
+		}
+		return fmt.Sprintf("genList(r, %s, %d, %d, %s)", nested, t.MinItems, t.MaxItems, p.genFunc(t.Items))
+	case *ir.Map:
+		return fmt.Sprintf("genMap(r, %s, %d, %d, %s, %s, func(k %s) Data { return %s })",
+			nested, t.MinItems, t.MaxItems, p.genFunc(t.Keys), p.genFunc(t.Values), p.typeOf(t.Keys), p.encode(t.Keys, "k"))
+	case *ir.Pair:
+		return fmt.Sprintf("%s{Key: %s, Value: %s}", p.typeOf(t), p.gen(t.Left, nested), p.gen(t.Right, nested))
+	}
+	return fmt.Sprintf("genData(r, %s)", depth)
+}
+
+// deeper returns the depth expression of the values nested in one at depth, such as
+// depth-2 for depth-1.
+func deeper(depth string) string {
+	if n, err := strconv.Atoi(strings.TrimPrefix(depth, "depth-")); err == nil {
+		return fmt.Sprintf("depth-%d", n+1)
+	}
+	return depth + "-1"
+}
+
+// writeRoundtripTest emits TestPlutusDataRoundtrip, which runs checkRoundtrip on random
+// values of every type with a generator.
+func (p *propertyFile) writeRoundtripTest(refNames []string) {
+	p.body.WriteString("// TestPlutusDataRoundtrip checks that random values of every type encode to CBOR that\n")
+	p.body.WriteString("// decodes to Plutus data of the shape given by the blueprint and encodes back to the\n")
+	p.body.WriteString("// same bytes.\n")
+	p.body.WriteString("func TestPlutusDataRoundtrip(t *testing.T) {\n")
+	p.body.WriteString("\ttests := []struct {\n\t\tname string\n\t\tref  string\n\t\tgen  func(r *rand.Rand) Data\n\t}{\n")
+	for _, refName := range refNames {
+		genName, ok := p.genNames[refName]
+		if !ok {
+			continue
+		}
+		value := p.encodeDefinition(refName, fmt.Sprintf("%s(r, genDepth)", genName))
+		p.body.WriteString(fmt.Sprintf("\t\t{%q, %q, func(r *rand.Rand) Data { return %s }},\n", p.chosenNames[refName], refName, value))
+	}
+	p.body.WriteString("\t}\n")
+	p.body.WriteString(`	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < roundtrips; i++ {
+				checkRoundtrip(t, tt.gen(r), plutusSchemas[tt.ref])
+			}
+		})
+	}
+}
+
+`)
+}
+
+// writeSchemas emits plutusSchemas, the Plutus data shape of every definition as given
+// by the blueprint, which checkRoundtrip checks decoded values against.
+func (p *propertyFile) writeSchemas(refNames []string) {
+	p.body.WriteString("// plutusSchemas holds the shape of the Plutus data of each definition of the blueprint.\n")
+	p.body.WriteString("var plutusSchemas = map[string]*schemaNode{\n")
+	for _, refName := range refNames {
+		p.body.WriteString(fmt.Sprintf("\t%q: %s,\n", refName, p.schemaNode(p.types.Definitions[refName].Type)))
+	}
+	p.body.WriteString("}\n\n")
+}
+
+// schemaNode returns a schemaNode literal, without its type, describing the Plutus data
+// of type t.
+func (p *propertyFile) schemaNode(t ir.Type) string {
+	switch t := t.(type) {
+	case *ir.Ref:
+		return fmt.Sprintf(`{kind: "ref", ref: %q}`, t.Key)
+	case *ir.Sum, *ir.Product:
+		constructors := ir.Constructors(t)
+		constrs := make([]string, len(constructors))
+		for i, cons := range constructors {
+			fields := make([]string, len(cons.Fields))
+			for j, field := range cons.Fields {
+				fields[j] = p.schemaNode(field.Type)
+			}
+			constrs[i] = fmt.Sprintf("{index: %d, fields: []*schemaNode{%s}}", cons.Index, strings.Join(fields, ", "))
+		}
+		return fmt.Sprintf(`{kind: "constr", constrs: []schemaConstr{%s}}`, strings.Join(constrs, ", "))
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return `{kind: "integer"}`
+		case ir.Bytes:
+			return `{kind: "bytes"}`
+		case ir.String:
+			return `{kind: "string"}`
+		case ir.Boolean:
+			return `{kind: "constr", constrs: []schemaConstr{{index: 0}, {index: 1}}}`
+		case ir.Unit:
+			return `{kind: "constr", constrs: []schemaConstr{{index: 0}}}`
+		}
+	case *ir.Tuple:
+		items := make([]string, len(t.Items))
+		for i, item := range t.Items {
+			items[i] = p.schemaNode(item)
+		}
+		return fmt.Sprintf(`{kind: "list", tuple: []*schemaNode{%s}}`, strings.Join(items, ", "))
+	case *ir.List:
+		bounds := schemaBounds(t.MinItems, t.MaxItems)
+		if _, ok := t.Items.(*ir.Opaque); ok {
+			return fmt.Sprintf(`{kind: "list"%s}`, bounds)
+		}
+		if t.UniqueItems {
+			bounds += ", unique: true"
+		}
+		return fmt.Sprintf(`{kind: "list", items: &schemaNode%s%s}`, p.schemaNode(t.Items), bounds)
+	case *ir.Map:
+		bounds := schemaBounds(t.MinItems, t.MaxItems)
+		_, opaqueKeys := t.Keys.(*ir.Opaque)
+		_, opaqueValues := t.Values.(*ir.Opaque)
+		if opaqueKeys || opaqueValues {
+			return fmt.Sprintf(`{kind: "map"%s}`, bounds)
+		}
+		return fmt.Sprintf(`{kind: "map", keys: &schemaNode%s, values: &schemaNode%s%s}`, p.schemaNode(t.Keys), p.schemaNode(t.Values), bounds)
+	case *ir.Pair:
+		return fmt.Sprintf(`{kind: "list", tuple: []*schemaNode{%s, %s}}`, p.schemaNode(t.Left), p.schemaNode(t.Right))
+	}
+	return `{kind: "any"}`
+}
+
+// schemaBounds returns the schemaNode fields bounding the items of a list or map.
+func schemaBounds(minItems, maxItems int) string {
+	bounds := ""
+	if minItems != 0 {
+		bounds += fmt.Sprintf(", minItems: %d", minItems)
+	}
+	if maxItems != 0 {
+		bounds += fmt.Sprintf(", maxItems: %d", maxItems)
+	}
+	return bounds
+}
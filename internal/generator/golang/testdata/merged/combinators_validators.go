@@ -0,0 +1,20 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:a9cf1fb0a4f63d70e768adf45e33e1eb217dd997d51703596069112e67da4f9b.
+
+package main
+
+// VaultVaultSpendValidator is validator vault.vault.spend.
+// Datum: Vault. Redeemer: Vault_Action.
+var VaultVaultSpendValidator = Validator{
+	Title:         "vault.vault.spend",
+	Purpose:       "spend",
+	PlutusVersion: "v3",
+	CompiledCode:  "450101002499",
+	Hash:          "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+	Addresses: map[string]string{
+		"mainnet": "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2",
+		"preprod": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+		"preview": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+	},
+}
@@ -0,0 +1,20 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:e8a2cf5cc15c4933aa8ad58a04b3e51c91a81d33ef95f0bd299e8ef7b0e050af.
+
+package main
+
+// EscrowEscrowSpendValidator is validator escrow.escrow.spend.
+// Datum: Escrow. Redeemer: Signers.
+var EscrowEscrowSpendValidator = Validator{
+	Title:         "escrow.escrow.spend",
+	Purpose:       "spend",
+	PlutusVersion: "v3",
+	CompiledCode:  "450101002499",
+	Hash:          "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+	Addresses: map[string]string{
+		"mainnet": "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2",
+		"preprod": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+		"preview": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+	},
+}
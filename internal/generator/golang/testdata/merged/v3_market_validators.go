@@ -0,0 +1,76 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:880d283515169a5b15174993dd047ca33343db16ea604569e07b2a361a746765.
+
+package main
+
+import (
+	"math/big"
+
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// MarketListingSpendValidator is validator market.listing.spend.
+// Datum: Listing. Redeemer: Action.
+//
+// Holds items for sale until they are bought or cancelled.
+//
+// - Datum `listing`: The listing being spent
+var MarketListingSpendValidator = Validator{
+	Title:         "market.listing.spend",
+	Purpose:       "spend",
+	PlutusVersion: "v3",
+	CompiledCode:  "581f01010025333573466e1cd55ce9baa357426ae88d55cf1baa00148000526161",
+	Hash:          "99f61e3c23f13561c4b25069c4bd4819cdfd27fa92d57677dce676bc",
+	Addresses: map[string]string{
+		"mainnet": "addr1wxvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q3jr0qt",
+		"preprod": "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w",
+		"preview": "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w",
+	},
+}
+
+// MarketListingMintValidator is validator market.listing.mint.
+// Redeemer: MintAction.
+var MarketListingMintValidator = Validator{
+	Title:         "market.listing.mint",
+	Purpose:       "mint",
+	PlutusVersion: "v3",
+	CompiledCode:  "450101002499",
+	Hash:          "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+	PolicyID:      "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+	Addresses: map[string]string{
+		"mainnet": "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2",
+		"preprod": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+		"preview": "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0",
+	},
+}
+
+// OracleFeedWithdrawValidator is validator oracle.feed.withdraw.
+// Redeemer: FeedRedeemer. Parameters: VerificationKeyHash, String, *big.Int.
+//
+// Price oracle whose owner publishes prices by withdrawing.
+//
+// - Parameter `owner`: Key allowed to publish prices
+// - Parameter `feed_name`: Human-readable feed name
+// - Parameter `decimals`: Number of decimals in published prices
+var OracleFeedWithdrawValidator = Validator{
+	Title:         "oracle.feed.withdraw",
+	Purpose:       "withdraw",
+	PlutusVersion: "v3",
+	CompiledCode:  "4701010022224981",
+	Hash:          "8584a137ba44ecf36aee085b7da213a1825c288743a0a479851905e8",
+}
+
+// ApplyOracleFeedWithdrawParams applies OracleFeedWithdrawValidator to its parameters. The result holds
+// the compiled code and hash of the applied script.
+func ApplyOracleFeedWithdrawParams(owner VerificationKeyHash, feedName String, decimals *big.Int) (Validator, error) {
+	v := OracleFeedWithdrawValidator
+	params, err := encodeParams(owner, []byte(feedName), decimals)
+	if err != nil {
+		return Validator{}, err
+	}
+	if v.CompiledCode, v.Hash, err = uplc.ApplyParamsCBOR(v.CompiledCode, v.PlutusVersion, params...); err != nil {
+		return Validator{}, err
+	}
+	return v, nil
+}
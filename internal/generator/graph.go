@@ -0,0 +1,170 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// Graph is the dependency graph between the definitions of a blueprint, as computed by
+// CollectDependenciesMemo, optionally rooted at a validator.
+type Graph struct {
+	// Nodes are definition reference names, and the validator title when the graph is
+	// rooted at one. The validator comes first; definitions follow in sorted order.
+	Nodes []string
+	Edges []GraphEdge
+}
+
+// GraphEdge is an edge of a Graph. Edges leaving a validator are labelled with the
+// argument they come from, such as "datum".
+type GraphEdge struct {
+	From, To string
+	Label    string
+}
+
+// DependencyGraph returns the dependency graph of the definitions of schema, resolved as
+// types. If validator
+// is set, the graph only holds the definitions reachable from that validator's arguments,
+// or from the one argument named by argument ("datum", "redeemer" or "parameter").
+// List and map instances that generators inline are looked through, as in
+// CollectDependenciesMemo.
+func DependencyGraph(schema *parser.PlutusSchema, types *ir.Schema, validator, argument string) (*Graph, error) {
+	memo := make(map[string][]string)
+	graph := &Graph{}
+
+	var roots []string
+	if validator == "" {
+		if argument != "" {
+			return nil, fmt.Errorf("an argument can only be selected along with a validator")
+		}
+		for refName := range types.Definitions {
+			if !IsInlinedCollectionRef(refName) {
+				roots = append(roots, refName)
+			}
+		}
+	} else {
+		var found *parser.PlutusValidator
+		for i := range schema.Validators {
+			if schema.Validators[i].Title == validator {
+				found = &schema.Validators[i]
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("validator %s not found", validator)
+		}
+		graph.Nodes = append(graph.Nodes, validator)
+		var labels []string
+		args := make(map[string][]parser.PlutusArgument)
+		if found.Datum != nil {
+			labels = append(labels, "datum")
+			args["datum"] = []parser.PlutusArgument{*found.Datum}
+		}
+		if found.Redeemer != nil {
+			labels = append(labels, "redeemer")
+			args["redeemer"] = []parser.PlutusArgument{*found.Redeemer}
+		}
+		if len(found.Parameters) > 0 {
+			labels = append(labels, "parameter")
+			args["parameter"] = found.Parameters
+		}
+		if argument != "" {
+			if _, ok := args[argument]; !ok {
+				return nil, fmt.Errorf("validator %s has no %s", validator, argument)
+			}
+			labels = []string{argument}
+		}
+		for _, label := range labels {
+			linked := make(map[string]bool)
+			for _, arg := range args[label] {
+				for _, dep := range Dependencies(types.Convert(arg.Schema), types) {
+					if !linked[dep] {
+						linked[dep] = true
+						graph.Edges = append(graph.Edges, GraphEdge{From: validator, To: dep, Label: label})
+						roots = append(roots, dep)
+					}
+				}
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	var visit func(refName string)
+	visit = func(refName string) {
+		if seen[refName] {
+			return
+		}
+		seen[refName] = true
+		for _, dep := range CollectDependenciesMemo(refName, types, memo) {
+			visit(dep)
+		}
+	}
+	for _, root := range roots {
+		visit(root)
+	}
+	refNames := make([]string, 0, len(seen))
+	for refName := range seen {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	graph.Nodes = append(graph.Nodes, refNames...)
+	for _, refName := range refNames {
+		for _, dep := range CollectDependenciesMemo(refName, types, memo) {
+			graph.Edges = append(graph.Edges, GraphEdge{From: refName, To: dep})
+		}
+	}
+	return graph, nil
+}
+
+// nodeIDs numbers the nodes of g for diagram formats that need plain identifiers.
+func (g *Graph) nodeIDs() map[string]string {
+	ids := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node] = fmt.Sprintf("n%d", i)
+	}
+	return ids
+}
+
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+
+// Mermaid renders g as a Mermaid flowchart.
+func (g *Graph) Mermaid() string {
+	ids := g.nodeIDs()
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, node := range g.Nodes {
+		sb.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", ids[node], mermaidEscaper.Replace(node)))
+	}
+	for _, edge := range g.Edges {
+		if edge.Label != "" {
+			sb.WriteString(fmt.Sprintf("  %s -->|%s| %s\n", ids[edge.From], edge.Label, ids[edge.To]))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s --> %s\n", ids[edge.From], ids[edge.To]))
+		}
+	}
+	return sb.String()
+}
+
+// DOT renders g as a Graphviz digraph.
+func (g *Graph) DOT() string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+	}
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n  rankdir=LR;\n  node [shape=box];\n")
+	for _, node := range g.Nodes {
+		sb.WriteString(fmt.Sprintf("  %s;\n", quote(node)))
+	}
+	for _, edge := range g.Edges {
+		if edge.Label != "" {
+			sb.WriteString(fmt.Sprintf("  %s -> %s [label=%s];\n", quote(edge.From), quote(edge.To), quote(edge.Label)))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s -> %s;\n", quote(edge.From), quote(edge.To)))
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestDependencyGraph(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := Resolve(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph, err := DependencyGraph(schema, types, "oracle.feed.withdraw", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantNodes := []string{"oracle.feed.withdraw", "Int", "String", "Tuple$Int_Int", "aiken/crypto/VerificationKeyHash", "oracle/FeedRedeemer"}
+	if !reflect.DeepEqual(graph.Nodes, wantNodes) {
+		t.Errorf("Nodes = %v, want %v", graph.Nodes, wantNodes)
+	}
+	wantEdges := []GraphEdge{
+		{From: "oracle.feed.withdraw", To: "oracle/FeedRedeemer", Label: "redeemer"},
+		{From: "oracle.feed.withdraw", To: "aiken/crypto/VerificationKeyHash", Label: "parameter"},
+		{From: "oracle.feed.withdraw", To: "String", Label: "parameter"},
+		{From: "Tuple$Int_Int", To: "Int"},
+		{From: "oracle/FeedRedeemer", To: "Int"},
+		{From: "oracle/FeedRedeemer", To: "Tuple$Int_Int"},
+	}
+	if !reflect.DeepEqual(graph.Edges, wantEdges) {
+		t.Errorf("Edges = %v, want %v", graph.Edges, wantEdges)
+	}
+
+	mermaid := graph.Mermaid()
+	for _, want := range []string{"flowchart LR\n", `  n5["oracle/FeedRedeemer"]`, "  n0 -->|redeemer| n5\n", "  n5 --> n3\n"} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("Mermaid() is missing %q:\n%s", want, mermaid)
+		}
+	}
+	dot := graph.DOT()
+	if want := `  "oracle.feed.withdraw" -> "String" [label="parameter"];`; !strings.Contains(dot, want) {
+		t.Errorf("DOT() is missing %q:\n%s", want, dot)
+	}
+
+	whole, err := DependencyGraph(schema, types, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range whole.Nodes {
+		if IsInlinedCollectionRef(node) {
+			t.Errorf("whole graph holds inlined collection %s", node)
+		}
+	}
+
+	for _, tc := range []struct{ validator, argument, err string }{
+		{"missing.spend", "", "validator missing.spend not found"},
+		{"market.listing.mint", "datum", "validator market.listing.mint has no datum"},
+		{"", "datum", "an argument can only be selected along with a validator"},
+	} {
+		if _, err := DependencyGraph(schema, types, tc.validator, tc.argument); err == nil || err.Error() != tc.err {
+			t.Errorf("DependencyGraph(%q, %q) error = %v, want %q", tc.validator, tc.argument, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/internal/version"
+)
+
+// FileHeader returns the lines, without comment markers, that open every generated file:
+// a warning against editing it, and the gogenesis version and blueprints it was generated
+// from, so that a file can be traced back to the tool that produced it.
+func FileHeader(schema *parser.PlutusSchema) []string {
+	origin := "Generated by gogenesis " + version.GetVersionString()
+	if len(schema.SourceHashes) > 0 {
+		hashes := make([]string, len(schema.SourceHashes))
+		for i, hash := range schema.SourceHashes {
+			hashes[i] = "sha256:" + hash
+		}
+		noun := "blueprint"
+		if len(hashes) > 1 {
+			noun = "blueprints"
+		}
+		origin += fmt.Sprintf(" from %s %s", noun, strings.Join(hashes, ", "))
+	}
+	return []string{
+		"AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.",
+		"Re-generate this by running the code generator script.",
+		origin + ".",
+	}
+}
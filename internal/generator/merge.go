@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// Project is one blueprint taking part in a merged generation.
+type Project struct {
+	// Name identifies the project; it names the project's validator module.
+	Name   string
+	Schema *parser.PlutusSchema
+}
+
+// MergeSchemas combines the definitions of several projects into one schema without
+// validators. A definition that several projects declare under the same key is kept once
+// if all declarations have the same shape (see SameShape); otherwise every such conflict
+// is reported.
+func MergeSchemas(projects []Project) (*parser.PlutusSchema, error) {
+	merged := &parser.PlutusSchema{Definitions: make(map[string]parser.PlutusDefinition)}
+	// owners maps each definition to the resolved definitions of the first project
+	// declaring it, and its name.
+	type owner struct {
+		name  string
+		types *ir.Schema
+	}
+	owners := make(map[string]owner)
+	var conflicts []error
+	versions := make(map[string]bool)
+	for _, project := range projects {
+		types, err := Resolve(project.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("project %s: %w", project.Name, err)
+		}
+		versions[project.Schema.PlutusVersion()] = true
+		merged.SourceHashes = append(merged.SourceHashes, project.Schema.SourceHashes...)
+		refNames := make([]string, 0, len(project.Schema.Definitions))
+		for refName := range project.Schema.Definitions {
+			refNames = append(refNames, refName)
+		}
+		sort.Strings(refNames)
+		for _, refName := range refNames {
+			first, ok := owners[refName]
+			if !ok {
+				owners[refName] = owner{name: project.Name, types: types}
+				merged.Definitions[refName] = project.Schema.Definitions[refName]
+				continue
+			}
+			if !SameShape(first.types.Definitions[refName].Type, first.types, types.Definitions[refName].Type, types) {
+				conflicts = append(conflicts, fmt.Errorf("definition %s differs between projects %s and %s", refName, first.name, project.Name))
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, errors.Join(conflicts...)
+	}
+	if len(versions) == 1 {
+		for version := range versions {
+			merged.Preamble.PlutusVersion = version
+		}
+	}
+	return merged, nil
+}
+
+// GenerateProjects generates one package for several projects: the merged definitions
+// (see MergeSchemas) are generated once, as Generate would, and the validators of every
+// project go to a module of their own. The CodeGenerator must implement ProjectGenerator.
+func (g *Generator) GenerateProjects(projects []Project) error {
+	projectGen, ok := g.CodeGen.(ProjectGenerator)
+	if !ok {
+		return fmt.Errorf("code generator does not support generating several projects")
+	}
+	names := make(map[string]bool)
+	for _, project := range projects {
+		if project.Name == "" {
+			return fmt.Errorf("project without a name")
+		}
+		if names[project.Name] {
+			return fmt.Errorf("duplicate project name %s", project.Name)
+		}
+		names[project.Name] = true
+	}
+
+	shared, err := MergeSchemas(projects)
+	if err != nil {
+		return fmt.Errorf("failed to merge blueprints: %w", err)
+	}
+	types, err := Resolve(shared)
+	if err != nil {
+		return err
+	}
+	chosenNames := g.chooseNames(shared)
+	files, err := g.generateFiles(shared, types, chosenNames)
+	if err != nil {
+		return err
+	}
+	modules, err := projectGen.GenerateProjects(projects, shared, types, chosenNames, g.Options)
+	if err != nil {
+		return err
+	}
+	for name, code := range modules {
+		if _, taken := files[name]; taken {
+			return fmt.Errorf("project module %s collides with a generated file", name)
+		}
+		files[name] = code
+	}
+	return g.writeFiles(files)
+}
+
+var nonFileNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ProjectFileStem returns the project name reduced to lower-case letters and digits,
+// with runs of other characters replaced by sep, for use in file names.
+func ProjectFileStem(name, sep string) string {
+	return strings.Trim(nonFileNameChars.ReplaceAllString(strings.ToLower(name), sep), sep)
+}
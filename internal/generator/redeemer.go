@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+)
+
+// IsWrappedRedeemer reports whether the definition identified by refName, of type t, is
+// a redeemer wrapped in an extra constructor, as Aiken emits for multi-validators so that
+// the purpose can be detected on-chain. The wrapper is a single untitled constructor with
+// index 1 holding exactly one untitled field that references the actual redeemer.
+//
+// Entries in opts.WrappedRedeemers take precedence over the structural detection. An
+// entry can only make a record of exactly one field a wrapper; CheckWrappedRedeemers
+// rejects the others before any code is generated.
+func IsWrappedRedeemer(refName string, t ir.Type, opts GeneratorOptions) bool {
+	record, ok := t.(*ir.Product)
+	if !ok {
+		return false
+	}
+	cons := record.Constructor
+	if wrapped, ok := opts.WrappedRedeemers[refName]; ok {
+		return wrapped && len(cons.Fields) == 1
+	}
+	if cons.Title != "" || cons.Index != 1 || len(cons.Fields) != 1 {
+		return false
+	}
+	field := cons.Fields[0]
+	_, ok = field.Type.(*ir.Ref)
+	return field.Title == "" && ok
+}
+
+// CheckWrappedRedeemers checks that the entries of opts.WrappedRedeemers forcing a
+// definition of types to be a wrapper name a record of exactly one field.
+func CheckWrappedRedeemers(types *ir.Schema, opts GeneratorOptions) error {
+	refNames := make([]string, 0, len(opts.WrappedRedeemers))
+	for refName, wrapped := range opts.WrappedRedeemers {
+		if wrapped {
+			refNames = append(refNames, refName)
+		}
+	}
+	// Report the first error in a fixed order.
+	sort.Strings(refNames)
+	for _, refName := range refNames {
+		def, ok := types.Definitions[refName]
+		if !ok {
+			return fmt.Errorf("wrapped redeemer %s: no such definition", refName)
+		}
+		if record, ok := def.Type.(*ir.Product); !ok || len(record.Constructor.Fields) != 1 {
+			return fmt.Errorf("wrapped redeemer %s: only a record of exactly one field can be a wrapper", refName)
+		}
+	}
+	return nil
+}
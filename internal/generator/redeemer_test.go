@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestIsWrappedRedeemer(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/wrapped_redeemer.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := Resolve(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		refName   string
+		overrides map[string]bool
+		want      bool
+	}{
+		{"RedeemerWrapper$lottery/Action", nil, true},
+		{"RedeemerWrapper$lottery/Action", map[string]bool{"RedeemerWrapper$lottery/Action": false}, false},
+		{"lottery/Pot", nil, false},
+		{"lottery/Action", nil, false},
+	}
+	for _, tt := range tests {
+		opts := GeneratorOptions{WrappedRedeemers: tt.overrides}
+		if got := IsWrappedRedeemer(tt.refName, types.Definitions[tt.refName].Type, opts); got != tt.want {
+			t.Errorf("IsWrappedRedeemer(%s, %v) = %v, want %v", tt.refName, tt.overrides, got, tt.want)
+		}
+	}
+}
+
+func TestCheckWrappedRedeemers(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/wrapped_redeemer.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := Resolve(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Overrides turning detection off apply to any definition.
+	opts := GeneratorOptions{WrappedRedeemers: map[string]bool{"RedeemerWrapper$lottery/Action": true, "Int": false}}
+	if err := CheckWrappedRedeemers(types, opts); err != nil {
+		t.Errorf("CheckWrappedRedeemers: %v", err)
+	}
+	// Only records of a single field can be wrappers.
+	for _, refName := range []string{"Int", "lottery/Action", "lottery/Pot", "lottery/Missing"} {
+		opts := GeneratorOptions{WrappedRedeemers: map[string]bool{refName: true}}
+		if err := CheckWrappedRedeemers(types, opts); err == nil || !strings.Contains(err.Error(), refName) {
+			t.Errorf("CheckWrappedRedeemers(%s) error = %v, want an error naming the definition", refName, err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// SizeLimits are the protocol limits compiled scripts must fit in, in bytes.
+type SizeLimits struct {
+	// MaxTxSize bounds each script, which must fit in the transaction carrying it,
+	// whether as a witness or as the reference script of an output.
+	MaxTxSize int
+	// MaxRefScriptSize bounds the total size of the scripts a transaction references.
+	MaxRefScriptSize int
+}
+
+// DefaultSizeLimits are the mainnet limits as of the Conway era.
+var DefaultSizeLimits = SizeLimits{MaxTxSize: 16384, MaxRefScriptSize: 204800}
+
+// ScriptSize is the size of a validator's compiled code, in bytes.
+type ScriptSize struct {
+	Title string
+	// Hash identifies the compiled code. Validators that share it, such as the handlers
+	// of a Plutus V3 validator, are a single script.
+	Hash string
+	Size int
+	// Previous is the size in the previous blueprint, or -1 if the validator is new.
+	Previous int
+}
+
+// SizeReport lists the script sizes of the validators of a blueprint, compared to a
+// previous version of it if one is given.
+type SizeReport struct {
+	Limits  SizeLimits
+	Scripts []ScriptSize
+	// Removed are the validators of the previous blueprint missing from the current one.
+	Removed     []ScriptSize
+	HasPrevious bool
+
+	previousTotal int
+}
+
+// NewSizeReport reports the script sizes of schema against limits. previous may be nil.
+func NewSizeReport(schema, previous *parser.PlutusSchema, limits SizeLimits) *SizeReport {
+	report := &SizeReport{Limits: limits, HasPrevious: previous != nil}
+	before := make(map[string]int)
+	if previous != nil {
+		for _, v := range previous.Validators {
+			before[v.Title] = scriptSize(v)
+		}
+		report.previousTotal = totalSize(previous.Validators)
+	}
+	current := make(map[string]bool)
+	for _, v := range schema.Validators {
+		current[v.Title] = true
+		size := ScriptSize{Title: v.Title, Hash: scriptHash(v), Size: scriptSize(v), Previous: -1}
+		if prev, ok := before[v.Title]; ok {
+			size.Previous = prev
+		}
+		report.Scripts = append(report.Scripts, size)
+	}
+	if previous != nil {
+		for _, v := range previous.Validators {
+			if !current[v.Title] {
+				report.Removed = append(report.Removed, ScriptSize{Title: v.Title, Hash: scriptHash(v), Previous: scriptSize(v)})
+			}
+		}
+	}
+	return report
+}
+
+// scriptSize returns the size of the compiled code of v as found in the blueprint.
+func scriptSize(v parser.PlutusValidator) int {
+	return len(v.CompiledCode) / 2
+}
+
+// scriptHash returns the hash of the compiled code of v as found in the blueprint, or the
+// code itself if the blueprint leaves the hash out: either tells scripts apart.
+func scriptHash(v parser.PlutusValidator) string {
+	if v.Hash != "" {
+		return v.Hash
+	}
+	return v.CompiledCode
+}
+
+// totalSize returns the total size of the scripts of validators, counting each once.
+func totalSize(validators []parser.PlutusValidator) int {
+	seen := make(map[string]bool)
+	total := 0
+	for _, v := range validators {
+		if hash := scriptHash(v); !seen[hash] {
+			seen[hash] = true
+			total += scriptSize(v)
+		}
+	}
+	return total
+}
+
+// Total returns the total size of the scripts, counting the validators that share a
+// script once.
+func (r *SizeReport) Total() int {
+	seen := make(map[string]bool)
+	total := 0
+	for _, s := range r.Scripts {
+		if !seen[s.Hash] {
+			seen[s.Hash] = true
+			total += s.Size
+		}
+	}
+	return total
+}
+
+// Exceeded reports whether a script exceeds the transaction size limit, or all of them
+// together the reference script limit.
+func (r *SizeReport) Exceeded() bool {
+	for _, s := range r.Scripts {
+		if s.Size > r.Limits.MaxTxSize {
+			return true
+		}
+	}
+	return r.Total() > r.Limits.MaxRefScriptSize
+}
+
+// Text renders the report as a table with a row per validator and a total.
+func (r *SizeReport) Text() string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	header := "VALIDATOR\tSIZE\tOF TX LIMIT\t"
+	if r.HasPrevious {
+		header += "CHANGE\t"
+	}
+	fmt.Fprintln(w, header+"STATUS")
+	for _, s := range r.Scripts {
+		status := "ok"
+		if s.Size > r.Limits.MaxTxSize {
+			status = fmt.Sprintf("exceeds transaction limit of %d bytes", r.Limits.MaxTxSize)
+		}
+		row := fmt.Sprintf("%s\t%d\t%s\t", s.Title, s.Size, percent(s.Size, r.Limits.MaxTxSize))
+		if r.HasPrevious {
+			row += sizeChange(s.Previous, s.Size) + "\t"
+		}
+		fmt.Fprintln(w, row+status)
+	}
+	for _, s := range r.Removed {
+		fmt.Fprintf(w, "%s\t-\t-\t%s\tremoved\n", s.Title, sizeChange(s.Previous, 0))
+	}
+
+	total := r.Total()
+	status := "ok"
+	if total > r.Limits.MaxRefScriptSize {
+		status = fmt.Sprintf("exceeds reference script limit of %d bytes", r.Limits.MaxRefScriptSize)
+	}
+	row := fmt.Sprintf("total\t%d\t\t", total)
+	if r.HasPrevious {
+		row += sizeChange(r.previousTotal, total) + "\t"
+	}
+	fmt.Fprintln(w, row+status)
+	w.Flush()
+	return buf.String()
+}
+
+// percent formats size as a percentage of limit.
+func percent(size, limit int) string {
+	return fmt.Sprintf("%.1f%%", 100*float64(size)/float64(limit))
+}
+
+// sizeChange formats the change from a previous size, which is -1 for new scripts.
+func sizeChange(previous, size int) string {
+	switch {
+	case previous < 0:
+		return "new"
+	case previous == size:
+		return "unchanged"
+	case previous == 0:
+		return fmt.Sprintf("%+d", size)
+	default:
+		return fmt.Sprintf("%+d (%+.1f%%)", size-previous, 100*float64(size-previous)/float64(previous))
+	}
+}
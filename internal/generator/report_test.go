@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestSizeReport(t *testing.T) {
+	schema := &parser.PlutusSchema{Validators: []parser.PlutusValidator{
+		{Title: "a.spend", CompiledCode: strings.Repeat("00", 120)},
+		{Title: "b.mint", CompiledCode: strings.Repeat("00", 40)},
+	}}
+	previous := &parser.PlutusSchema{Validators: []parser.PlutusValidator{
+		{Title: "a.spend", CompiledCode: strings.Repeat("00", 100)},
+		{Title: "c.withdraw", CompiledCode: strings.Repeat("00", 10)},
+	}}
+
+	report := NewSizeReport(schema, previous, SizeLimits{MaxTxSize: 100, MaxRefScriptSize: 150})
+	if !report.Exceeded() {
+		t.Error("Exceeded() = false, want true")
+	}
+	if got := report.Total(); got != 160 {
+		t.Errorf("Total() = %d, want 160", got)
+	}
+	want := strings.Join([]string{
+		"VALIDATOR   SIZE  OF TX LIMIT  CHANGE         STATUS",
+		"a.spend     120   120.0%       +20 (+20.0%)   exceeds transaction limit of 100 bytes",
+		"b.mint      40    40.0%        new            ok",
+		"c.withdraw  -     -            -10 (-100.0%)  removed",
+		"total       160                +50 (+45.5%)   exceeds reference script limit of 150 bytes",
+		"",
+	}, "\n")
+	if got := report.Text(); got != want {
+		t.Errorf("Text() =\n%s\nwant\n%s", got, want)
+	}
+
+	report = NewSizeReport(previous, nil, DefaultSizeLimits)
+	if report.Exceeded() {
+		t.Error("Exceeded() = true, want false")
+	}
+	if strings.Contains(report.Text(), "CHANGE") {
+		t.Errorf("Text() without a previous blueprint has a CHANGE column:\n%s", report.Text())
+	}
+}
+
+func TestSizeReportSharedScripts(t *testing.T) {
+	// The handlers of a V3 validator share its compiled code, which counts once.
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_treasury.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := &parser.PlutusSchema{Validators: []parser.PlutusValidator{
+		{Title: "treasury.treasury.spend", CompiledCode: strings.Repeat("00", 5)},
+		{Title: "treasury.treasury.withdraw", CompiledCode: strings.Repeat("00", 5)},
+		{Title: "treasury.treasury.else", CompiledCode: strings.Repeat("00", 5)},
+	}}
+
+	report := NewSizeReport(schema, previous, SizeLimits{MaxTxSize: 20, MaxRefScriptSize: 20})
+	if len(report.Scripts) != 3 {
+		t.Fatalf("%d scripts, want 3", len(report.Scripts))
+	}
+	if got := report.Total(); got != 11 {
+		t.Errorf("Total() = %d, want 11", got)
+	}
+	if report.Exceeded() {
+		t.Error("Exceeded() = true, want false")
+	}
+	if text := report.Text(); !strings.Contains(text, "total                       11                 +6 (+120.0%)  ok") {
+		t.Errorf("Text() =\n%s", text)
+	}
+}
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/mgpai22/gogenesis/internal/address"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// NetworkAddress is an address on a named network.
+type NetworkAddress struct {
+	Network string
+	Address string
+}
+
+// ScriptInfo holds the values derived from the compiled code of a validator.
+type ScriptInfo struct {
+	// Hash is the script hash, in hex. It is the policy ID of minting validators.
+	Hash string
+	// Addresses are the enterprise addresses of the script on each of address.Networks.
+	Addresses []NetworkAddress
+}
+
+// ValidatorScriptInfo hashes the compiled code of v and derives its addresses. It fails
+// if the hash differs from the one the blueprint declares.
+func ValidatorScriptInfo(v parser.PlutusValidator, plutusVersion string) (*ScriptInfo, error) {
+	if v.CompiledCode == "" {
+		return nil, fmt.Errorf("validator %s has no compiled code", v.Title)
+	}
+	hash, err := uplc.CompiledCodeHash(v.CompiledCode, plutusVersion)
+	if err != nil {
+		return nil, fmt.Errorf("validator %s: %w", v.Title, err)
+	}
+	info := &ScriptInfo{Hash: hex.EncodeToString(hash)}
+	if v.Hash != "" && v.Hash != info.Hash {
+		return nil, fmt.Errorf("validator %s: hash %s does not match the hash of its compiled code, %s", v.Title, v.Hash, info.Hash)
+	}
+	payment := address.Credential{Hash: hash, Script: true}
+	for _, network := range address.Networks {
+		info.Addresses = append(info.Addresses, NetworkAddress{
+			Network: network.Name,
+			Address: address.Enterprise(network, payment),
+		})
+	}
+	return info, nil
+}
+
+// BakedScriptInfo returns the script info generated code embeds for v, or nil if there
+// is none: parameterized validators only have addresses once applied, and validators
+// whose hash cannot be checked are left as the blueprint describes them.
+func BakedScriptInfo(v parser.PlutusValidator, plutusVersion string) *ScriptInfo {
+	if len(v.Parameters) > 0 {
+		return nil
+	}
+	info, err := ValidatorScriptInfo(v, plutusVersion)
+	if err != nil {
+		return nil
+	}
+	return info
+}
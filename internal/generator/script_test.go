@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestValidatorScriptInfo(t *testing.T) {
+	v := parser.PlutusValidator{
+		Title:        "market.listing.mint",
+		CompiledCode: "450101002499",
+		Hash:         "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+	}
+	info, err := ValidatorScriptInfo(v, "v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Hash != v.Hash {
+		t.Errorf("Hash = %s, want %s", info.Hash, v.Hash)
+	}
+	want := []NetworkAddress{
+		{"mainnet", "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2"},
+		{"preprod", "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0"},
+		{"preview", "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0"},
+	}
+	if len(info.Addresses) != len(want) {
+		t.Fatalf("Addresses = %v, want %v", info.Addresses, want)
+	}
+	for i := range want {
+		if info.Addresses[i] != want[i] {
+			t.Errorf("Addresses[%d] = %v, want %v", i, info.Addresses[i], want[i])
+		}
+	}
+
+	v.Hash = "00" + v.Hash[2:]
+	if _, err := ValidatorScriptInfo(v, "v3"); err == nil || !strings.Contains(err.Error(), "does not match the hash of its compiled code") {
+		t.Errorf("mismatched hash error = %v", err)
+	}
+	if BakedScriptInfo(v, "v3") != nil {
+		t.Error("BakedScriptInfo returned info for a mismatched hash")
+	}
+}
@@ -0,0 +1,80 @@
+package generator
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// ScriptContextGenerator is implemented by CodeGenerators that can emit the ledger types
+// a script is run with, such as ScriptContext and TxInfo, so that tests can build script
+// contexts with the same encoding as the generated datum types. Given the script context
+// definitions of a Plutus version (see ScriptContextSchema) and their names, it returns
+// the files declaring them, keyed by file name.
+type ScriptContextGenerator interface {
+	GenerateScriptContext(version string, schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts GeneratorOptions) (map[string]string, error)
+}
+
+//go:embed scriptcontext_v2.json
+var scriptContextV2JSON []byte
+
+//go:embed scriptcontext_v3.json
+var scriptContextV3JSON []byte
+
+// ScriptContextVersions lists the Plutus versions ScriptContextSchema describes.
+var ScriptContextVersions = []string{parser.PlutusV2, parser.PlutusV3}
+
+// ScriptContextSchema returns the definitions of the script context of a Plutus version
+// and the ledger types it is made of, following the Plutus ledger API. The schema has no
+// validators.
+func ScriptContextSchema(version string) (*parser.PlutusSchema, error) {
+	var data []byte
+	switch version {
+	case parser.PlutusV2:
+		data = scriptContextV2JSON
+	case parser.PlutusV3:
+		data = scriptContextV3JSON
+	default:
+		return nil, fmt.Errorf("no script context types for Plutus version %q", version)
+	}
+	var schema parser.PlutusSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid embedded script context for %s: %w", version, err)
+	}
+	return &schema, nil
+}
+
+// generateScriptContexts returns the files declaring the script context types of each
+// version in opts.ScriptContexts.
+func (g *Generator) generateScriptContexts() (map[string]string, error) {
+	files := make(map[string]string)
+	if len(g.Options.ScriptContexts) == 0 {
+		return files, nil
+	}
+	scGen, ok := g.CodeGen.(ScriptContextGenerator)
+	if !ok {
+		return nil, errors.New("the code generator does not support script context types")
+	}
+	for _, version := range g.Options.ScriptContexts {
+		schema, err := ScriptContextSchema(version)
+		if err != nil {
+			return nil, err
+		}
+		types, err := Resolve(schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s script context: %w", version, err)
+		}
+		generated, err := scGen.GenerateScriptContext(version, schema, types, g.chooseNames(schema), g.Options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the %s script context: %w", version, err)
+		}
+		for name, code := range generated {
+			files[name] = code
+		}
+	}
+	return files, nil
+}
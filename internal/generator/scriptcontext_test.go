@@ -0,0 +1,33 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+)
+
+func TestScriptContextSchema(t *testing.T) {
+	for _, version := range ScriptContextVersions {
+		schema, err := ScriptContextSchema(version)
+		if err != nil {
+			t.Fatalf("%s: %v", version, err)
+		}
+		if schema.PlutusVersion() != version {
+			t.Errorf("%s: PlutusVersion() = %s", version, schema.PlutusVersion())
+		}
+		for _, name := range []string{"ScriptContext", "TxInfo", "TxOut", "Value", "Credential"} {
+			if _, ok := schema.Definitions[name]; !ok {
+				t.Errorf("%s: no definition for %s", version, name)
+			}
+		}
+		// Resolving the definitions checks that they only refer to each other.
+		if _, err := ir.Build(schema.Definitions); err != nil {
+			t.Errorf("%s: %v", version, err)
+		}
+	}
+
+	if _, err := ScriptContextSchema("v1"); err == nil || !strings.Contains(err.Error(), `no script context types for Plutus version "v1"`) {
+		t.Errorf("v1: error = %v", err)
+	}
+}
@@ -0,0 +1,393 @@
+package typescript
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// arbitrariesFileName and propertyTestFileName are the modules generated with
+// GeneratorOptions.PropertyTests.
+const (
+	arbitrariesFileName  = "plutus-arbitraries.ts"
+	propertyTestFileName = "plutus-types.test.ts"
+)
+
+// arbitraryHelpers holds the arbitraries of primitive and opaque data the generated
+// arbitraries are built from; it follows the imports of the arbitraries module.
+//
+//go:embed plutus-arbitraries.ts.tmpl
+var arbitraryHelpers string
+
+// depthMode tells how an arbitrary refers to the arbitraries of recursive types, which
+// are fc.memo functions of the remaining depth.
+type depthMode int
+
+const (
+	// topLevel arbitraries are not recursive and start recursive ones at arbitraryDepth.
+	topLevel depthMode = iota
+	// nested arbitraries are part of a recursive type and pass on depth - 1.
+	nested
+	// shallow arbitraries are those of recursive types at depth zero. They leave lists
+	// and maps empty, options unset and only use constructors that do not recurse.
+	shallow
+)
+
+// arbitraryModule builds fast-check arbitraries whose values have the static types of
+// the schemas GenerateTSSchema emits.
+type arbitraryModule struct {
+	types       *ir.Schema
+	chosenNames map[string]string
+	opts        generator.GeneratorOptions
+	// memo holds the members of recursive components, whose arbitraries are fc.memo.
+	memo map[string]bool
+}
+
+// generatePropertyModules returns plutus-arbitraries.ts, exporting an XArbitrary for each
+// type X of the types module, and plutus-types.test.ts, checking with node:test that
+// their values survive Data.to and Data.from unchanged. The arbitraries honour
+// constructors, list bounds, unique items and distinct map keys. Definitions bound to
+// custom schemas, and those depending on them, are skipped; the shared well-known types
+// have the blueprint's shape and are generated from it.
+func generatePropertyModules(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions, typesModule string) map[string]string {
+	a := &arbitraryModule{types: types, chosenNames: chosenNames, opts: opts, memo: make(map[string]bool)}
+	components, deps := generator.DefinitionComponents(types)
+	for _, component := range components {
+		if generator.IsRecursive(component, deps) {
+			for _, refName := range component {
+				a.memo[refName] = true
+			}
+		}
+	}
+	unsupported := a.unsupportedDefinitions(components)
+
+	var body strings.Builder
+	var typeNames, tested []string
+	for _, component := range components {
+		for _, refName := range component {
+			if unsupported[refName] {
+				continue
+			}
+			typeName := a.typeName(refName)
+			typeNames = append(typeNames, typeName)
+			tested = append(tested, refName)
+			body.WriteString("// -----------------------------\n")
+			body.WriteString(fmt.Sprintf("// Arbitrary %s\n", refName))
+			if a.memo[refName] {
+				body.WriteString(fmt.Sprintf("export const %sArbitrary: fc.Memo<%s> = fc.memo((depth) =>\n  depth <= 0\n    ? %s\n    : %s,\n);\n\n",
+					typeName, typeName, a.definitionArbitrary(refName, shallow), a.definitionArbitrary(refName, nested)))
+			} else {
+				body.WriteString(fmt.Sprintf("export const %sArbitrary: fc.Arbitrary<%s> = %s;\n\n", typeName, typeName, a.definitionArbitrary(refName, topLevel)))
+			}
+		}
+	}
+
+	var arbitraries strings.Builder
+	writeModuleHeader(&arbitraries, schema)
+	arbitraries.WriteString("import fc from 'fast-check';\n")
+	arbitraries.WriteString("import { Constr, type Data } from '@lucid-evolution/lucid';\n")
+	if len(typeNames) > 0 {
+		arbitraries.WriteString(fmt.Sprintf("import type { %s } from '%s';\n", strings.Join(typeNames, ", "), typesModule))
+	}
+	arbitraries.WriteString("\n")
+	arbitraries.WriteString(arbitraryHelpers)
+	arbitraries.WriteString(body.String())
+
+	var tests strings.Builder
+	writeModuleHeader(&tests, schema)
+	tests.WriteString("import { test } from 'node:test';\n")
+	tests.WriteString("import assert from 'node:assert/strict';\n")
+	tests.WriteString("import fc from 'fast-check';\n")
+	tests.WriteString("import { Data } from '@lucid-evolution/lucid';\n")
+	if len(tested) > 0 {
+		tests.WriteString(fmt.Sprintf("import { %s } from '%s';\n", strings.Join(typeNames, ", "), typesModule))
+		var arbitraryNames []string
+		recursive := false
+		for _, refName := range tested {
+			recursive = recursive || a.memo[refName]
+			arbitraryNames = append(arbitraryNames, a.typeName(refName)+"Arbitrary")
+		}
+		if recursive {
+			arbitraryNames = append([]string{"arbitraryDepth"}, arbitraryNames...)
+		}
+		tests.WriteString(fmt.Sprintf("import { %s } from './%s';\n", strings.Join(arbitraryNames, ", "), strings.TrimSuffix(arbitrariesFileName, ".ts")))
+	}
+	tests.WriteString("\n")
+	for _, refName := range tested {
+		typeName := a.typeName(refName)
+		arbitrary := typeName + "Arbitrary"
+		if a.memo[refName] {
+			arbitrary += "(arbitraryDepth)"
+		}
+		tests.WriteString(fmt.Sprintf("test(%q, () => {\n", typeName+" roundtrips through CBOR"))
+		tests.WriteString(fmt.Sprintf("  fc.assert(\n    fc.property(%s, (value) => {\n", arbitrary))
+		tests.WriteString(fmt.Sprintf("      assert.deepStrictEqual(Data.from(Data.to(value, %s), %s), value);\n", typeName, typeName))
+		tests.WriteString("    }),\n  );\n});\n\n")
+	}
+
+	return map[string]string{
+		arbitrariesFileName:  arbitraries.String(),
+		propertyTestFileName: tests.String(),
+	}
+}
+
+// writeModuleHeader writes the file header (see generator.FileHeader) of a module.
+func writeModuleHeader(builder *strings.Builder, schema *parser.PlutusSchema) {
+	for _, line := range generator.FileHeader(schema) {
+		builder.WriteString("// " + line + "\n")
+	}
+}
+
+// typeName returns the name the types module exports the type of a definition under.
+func (a *arbitraryModule) typeName(refName string) string {
+	return strings.ReplaceAll(a.chosenNames[refName], " ", "_")
+}
+
+// unsupportedDefinitions returns the definitions no arbitrary can be built for: those
+// bound to custom schemas, whose values cannot be derived from the blueprint, and those
+// depending on them.
+func (a *arbitraryModule) unsupportedDefinitions(components [][]string) map[string]bool {
+	unsupported := make(map[string]bool)
+	memo := make(map[string][]string)
+	// Components come in dependency order, and the members of a component depend on each
+	// other, so a component is unsupported as soon as one of its members is.
+	for _, component := range components {
+		bad := false
+		for _, refName := range component {
+			if t, _, ok := a.opts.WellKnown.Lookup(refName, a.types); ok && t.TypeScript != nil && t.Builtin == "" && t.TypeScript.Module != generator.CommonModule {
+				bad = true
+			}
+			for _, dep := range generator.CollectDependenciesMemo(refName, a.types, memo) {
+				if unsupported[dep] {
+					bad = true
+				}
+			}
+		}
+		if bad {
+			for _, refName := range component {
+				unsupported[refName] = true
+			}
+		}
+	}
+	return unsupported
+}
+
+// definitionArbitrary returns the arbitrary of a definition, mirroring tsSchemaExpression.
+func (a *arbitraryModule) definitionArbitrary(refName string, mode depthMode) string {
+	def := a.types.Definitions[refName]
+	if t, args, ok := a.opts.WellKnown.Lookup(refName, a.types); ok && t.TypeScript != nil {
+		switch t.Builtin {
+		case generator.BuiltinBool:
+			return "fc.boolean()"
+		case generator.BuiltinOption:
+			if mode == shallow {
+				return "fc.constant(null)"
+			}
+			return fmt.Sprintf("fc.option(%s, { nil: null })", a.refArbitrary(args[0], mode))
+		}
+	}
+	if generator.IsWrappedRedeemer(refName, def.Type, a.opts) {
+		// Only the Wrapped constructor holds a redeemer; Dummy is a placeholder.
+		return fmt.Sprintf("fc.record({ Wrapped: fc.tuple(%s) })", a.typeArbitrary(ir.Constructors(def.Type)[0].Fields[0].Type, mode))
+	}
+	if sum, ok := def.Type.(*ir.Sum); ok && mode == shallow {
+		if base := generator.BaseConstructors(refName, a.types); len(base) > 0 {
+			alts := make([]string, len(base))
+			for i, position := range base {
+				alts[i] = a.constructorArbitrary(sum.Constructors[position], mode)
+			}
+			return fmt.Sprintf("fc.oneof(%s)", strings.Join(alts, ", "))
+		}
+	}
+	return a.typeArbitrary(def.Type, mode)
+}
+
+// typeArbitrary returns the arbitrary of a type, mirroring generateSchemaExpression and
+// generateRefExpression.
+func (a *arbitraryModule) typeArbitrary(t ir.Type, mode depthMode) string {
+	switch t := t.(type) {
+	case *ir.Ref:
+		return a.refArbitrary(t.Key, mode)
+	case *ir.Sum:
+		alts := make([]string, len(t.Constructors))
+		for i, cons := range t.Constructors {
+			alts[i] = a.constructorArbitrary(cons, mode)
+		}
+		return fmt.Sprintf("fc.oneof(%s)", strings.Join(alts, ", "))
+	case *ir.Product:
+		return a.recordArbitrary(t.Constructor, t.Title, mode)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return "integerArbitrary"
+		case ir.Boolean:
+			return "fc.boolean()"
+		case ir.Unit:
+			return "fc.constant({})"
+		default:
+			return "bytesArbitrary"
+		}
+	case *ir.List:
+		return a.listArbitrary(t, mode)
+	case *ir.Map:
+		return a.mapArbitrary(t, mode)
+	case *ir.Tuple:
+		return a.tupleArbitrary(t.Items, mode)
+	case *ir.Pair:
+		return fmt.Sprintf("fc.tuple(%s, %s)", a.typeArbitrary(t.Left, mode), a.typeArbitrary(t.Right, mode))
+	default:
+		return a.dataArbitrary(mode)
+	}
+}
+
+// constructorArbitrary returns the arbitrary of a constructor of an enum, mirroring
+// generateConstructorInEnum.
+func (a *arbitraryModule) constructorArbitrary(cons *ir.Constructor, mode depthMode) string {
+	title := cons.Title
+	if title == "" {
+		title = "Unknown"
+	}
+	if len(cons.Fields) == 0 {
+		return fmt.Sprintf("fc.constant(%q as const)", title)
+	}
+	return fmt.Sprintf("fc.record({ %s: fc.tuple(%s) })", propertyKey(title), a.fieldArbitraries(cons, mode))
+}
+
+// recordArbitrary returns the arbitrary of a single-constructor type, mirroring
+// generateSingleConstructor and generateConstructorAsObject.
+func (a *arbitraryModule) recordArbitrary(cons *ir.Constructor, parentTitle string, mode depthMode) string {
+	if len(cons.Fields) == 0 {
+		return "fc.constant({})"
+	}
+	if parentTitle != "" && cons.Title == parentTitle {
+		fields := make([]string, len(cons.Fields))
+		for i, field := range cons.Fields {
+			fields[i] = fmt.Sprintf("%s: %s", propertyKey(field.Title), a.typeArbitrary(field.Type, mode))
+		}
+		return fmt.Sprintf("fc.record({ %s })", strings.Join(fields, ", "))
+	}
+	for _, field := range cons.Fields {
+		if field.Title == "" {
+			return fmt.Sprintf("fc.tuple(%s)", a.fieldArbitraries(cons, mode))
+		}
+	}
+	return a.constructorArbitrary(cons, mode)
+}
+
+// fieldArbitraries returns the arbitraries of the fields of a constructor, separated by
+// commas.
+func (a *arbitraryModule) fieldArbitraries(cons *ir.Constructor, mode depthMode) string {
+	fields := make([]string, len(cons.Fields))
+	for i, field := range cons.Fields {
+		fields[i] = a.typeArbitrary(field.Type, mode)
+	}
+	return strings.Join(fields, ", ")
+}
+
+// refArbitrary returns the arbitrary of the definition with key refName. List and map
+// instances are inlined like their schemas.
+func (a *arbitraryModule) refArbitrary(refName string, mode depthMode) string {
+	if def, ok := a.types.Definitions[refName]; ok && generator.IsInlinedCollectionRef(refName) {
+		switch t := def.Type.(type) {
+		case *ir.Tuple:
+			return a.tupleArbitrary(t.Items, mode)
+		case *ir.Map:
+			return a.mapArbitrary(t, mode)
+		case *ir.List:
+			return a.listArbitrary(t, mode)
+		}
+	}
+	return a.memoCall(a.typeName(refName)+"Arbitrary", a.memo[refName], mode)
+}
+
+// dataArbitrary returns the arbitrary of opaque Plutus data.
+func (a *arbitraryModule) dataArbitrary(mode depthMode) string {
+	return a.memoCall("dataArbitrary", true, mode)
+}
+
+// memoCall returns a reference to the arbitrary name, calling it with the depth to
+// recurse to if it is an fc.memo.
+func (a *arbitraryModule) memoCall(name string, memo bool, mode depthMode) string {
+	if !memo {
+		return name
+	}
+	switch mode {
+	case nested:
+		return name + "(depth - 1)"
+	case shallow:
+		return name + "(0)"
+	default:
+		return name + "(arbitraryDepth)"
+	}
+}
+
+// tupleArbitrary returns the arbitrary of a tuple, mirroring generateTupleExpression.
+func (a *arbitraryModule) tupleArbitrary(items []ir.Type, mode depthMode) string {
+	arbs := make([]string, len(items))
+	for i, item := range items {
+		arbs[i] = a.typeArbitrary(item, mode)
+	}
+	return fmt.Sprintf("fc.tuple(%s)", strings.Join(arbs, ", "))
+}
+
+// listArbitrary returns the arbitrary of a list, mirroring generateListExpression.
+func (a *arbitraryModule) listArbitrary(l *ir.List, mode depthMode) string {
+	item := a.typeArbitrary(l.Items, mode)
+	if mode == shallow && l.MinItems == 0 {
+		return "fc.constant([])"
+	}
+	constraints := lengthConstraints(l.MinItems, l.MaxItems, mode)
+	if l.UniqueItems {
+		constraints = append([]string{"selector: (x) => fc.stringify(x)"}, constraints...)
+		return fmt.Sprintf("fc.uniqueArray(%s, { %s })", item, strings.Join(constraints, ", "))
+	}
+	if len(constraints) == 0 {
+		return fmt.Sprintf("fc.array(%s)", item)
+	}
+	return fmt.Sprintf("fc.array(%s, { %s })", item, strings.Join(constraints, ", "))
+}
+
+// mapArbitrary returns the arbitrary of a map, mirroring generateMapExpression. Lists of
+// pairs are maps too.
+func (a *arbitraryModule) mapArbitrary(m *ir.Map, mode depthMode) string {
+	if mode == shallow && m.MinItems == 0 {
+		return "fc.constant(new Map())"
+	}
+	keyArb, valueArb := a.typeArbitrary(m.Keys, mode), a.typeArbitrary(m.Values, mode)
+	if constraints := lengthConstraints(m.MinItems, m.MaxItems, mode); len(constraints) > 0 {
+		return fmt.Sprintf("mapOf(%s, %s, { %s })", keyArb, valueArb, strings.Join(constraints, ", "))
+	}
+	return fmt.Sprintf("mapOf(%s, %s)", keyArb, valueArb)
+}
+
+// lengthConstraints returns the fast-check length constraints of a list or map. Shallow
+// arbitraries hold as few items as allowed.
+func lengthConstraints(minItems, maxItems int, mode depthMode) []string {
+	var constraints []string
+	if minItems != 0 {
+		constraints = append(constraints, fmt.Sprintf("minLength: %d", minItems))
+	}
+	switch {
+	case mode == shallow:
+		constraints = append(constraints, fmt.Sprintf("maxLength: %d", minItems))
+	case maxItems != 0:
+		constraints = append(constraints, fmt.Sprintf("maxLength: %d", maxItems))
+	}
+	return constraints
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][\w$]*$`)
+
+// propertyKey returns name as the key of an object literal, quoted unless it is an
+// identifier.
+func propertyKey(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
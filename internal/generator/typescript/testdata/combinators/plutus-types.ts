@@ -0,0 +1,115 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:a9cf1fb0a4f63d70e768adf45e33e1eb217dd997d51703596069112e67da4f9b.
+import { Data } from '@lucid-evolution/lucid';
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for Opaque
+export const OpaqueSchema = Data.Any();
+
+export type Opaque = Data.Static<typeof OpaqueSchema>;
+export const Opaque = OpaqueSchema as unknown as Opaque;
+
+// -----------------------------
+// Schema for Owner
+/**
+ * An alias carrying its own title
+ */
+export const OwnerSchema = ByteArraySchema;
+
+/**
+ * An alias carrying its own title
+ */
+export type Owner = Data.Static<typeof OwnerSchema>;
+export const Owner = OwnerSchema as unknown as Owner;
+
+// -----------------------------
+// Schema for vault/Action$Deposit$amount
+export const AmountSchema = Data.Integer();
+
+export type Amount = Data.Static<typeof AmountSchema>;
+export const Amount = AmountSchema as unknown as Amount;
+
+// -----------------------------
+// Schema for vault/Action
+export const ActionSchema = Data.Enum([Data.Object({ Deposit: Data.Tuple([AmountSchema]) }), Data.Object({ /** - `to`: Recipient of the funds */ Withdraw: Data.Tuple([OwnerSchema]) })]);
+
+export type Action = Data.Static<typeof ActionSchema>;
+export const Action = ActionSchema as unknown as Action;
+
+// -----------------------------
+// Schema for vault/Vault$limits
+export const LimitsSchema = Data.Map(ByteArraySchema, IntSchema);
+
+export type Limits = Data.Static<typeof LimitsSchema>;
+export const Limits = LimitsSchema as unknown as Limits;
+
+// -----------------------------
+// Schema for vault/Vault$memo
+export const MemoSchema = Data.Bytes();
+
+export type Memo = Data.Static<typeof MemoSchema>;
+export const Memo = MemoSchema as unknown as Memo;
+
+// -----------------------------
+// Schema for vault/Vault$mode
+export const ModeSchema = Data.Enum([Data.Literal("Open"), Data.Literal("Locked")]);
+
+export type Mode = Data.Static<typeof ModeSchema>;
+export const Mode = ModeSchema as unknown as Mode;
+
+// -----------------------------
+// Schema for vault/Vault
+export const VaultSchema = Data.Object({ owner: OwnerSchema, limits: LimitsSchema, mode: ModeSchema, memo: MemoSchema });
+
+export type Vault = Data.Static<typeof VaultSchema>;
+export const Vault = VaultSchema as unknown as Vault;
+
+// -----------------------------
+// Validator vault.vault.spend
+export const VaultVaultSpendValidator = {
+  title: "vault.vault.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  datum: VaultSchema,
+  redeemer: ActionSchema,
+} as const;
+
+export const VaultVaultSpendDatumSchema = VaultSchema;
+export type VaultVaultSpendDatum = Data.Static<typeof VaultVaultSpendDatumSchema>;
+/** Encodes a datum of vault.vault.spend as CBOR hex. */
+export function encodeVaultVaultSpendDatum(value: VaultVaultSpendDatum): string {
+  return Data.to(value, VaultVaultSpendDatumSchema as unknown as VaultVaultSpendDatum);
+}
+/** Decodes a datum of vault.vault.spend from CBOR hex. */
+export function decodeVaultVaultSpendDatum(cbor: string): VaultVaultSpendDatum {
+  return Data.from(cbor, VaultVaultSpendDatumSchema as unknown as VaultVaultSpendDatum);
+}
+
+export const VaultVaultSpendRedeemerSchema = ActionSchema;
+export type VaultVaultSpendRedeemer = Data.Static<typeof VaultVaultSpendRedeemerSchema>;
+/** Encodes a redeemer of vault.vault.spend as CBOR hex. */
+export function encodeVaultVaultSpendRedeemer(value: VaultVaultSpendRedeemer): string {
+  return Data.to(value, VaultVaultSpendRedeemerSchema as unknown as VaultVaultSpendRedeemer);
+}
+/** Decodes a redeemer of vault.vault.spend from CBOR hex. */
+export function decodeVaultVaultSpendRedeemer(cbor: string): VaultVaultSpendRedeemer {
+  return Data.from(cbor, VaultVaultSpendRedeemerSchema as unknown as VaultVaultSpendRedeemer);
+}
+
@@ -0,0 +1,40 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:a9cf1fb0a4f63d70e768adf45e33e1eb217dd997d51703596069112e67da4f9b.
+import { Data } from '@lucid-evolution/lucid';
+import { VaultSchema, Vault_ActionSchema } from './plutus-types';
+
+// -----------------------------
+// Validator vault.vault.spend
+export const VaultVaultSpendValidator = {
+  title: "vault.vault.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  datum: VaultSchema,
+  redeemer: Vault_ActionSchema,
+} as const;
+
+export const VaultVaultSpendDatumSchema = VaultSchema;
+export type VaultVaultSpendDatum = Data.Static<typeof VaultVaultSpendDatumSchema>;
+/** Encodes a datum of vault.vault.spend as CBOR hex. */
+export function encodeVaultVaultSpendDatum(value: VaultVaultSpendDatum): string {
+  return Data.to(value, VaultVaultSpendDatumSchema as unknown as VaultVaultSpendDatum);
+}
+/** Decodes a datum of vault.vault.spend from CBOR hex. */
+export function decodeVaultVaultSpendDatum(cbor: string): VaultVaultSpendDatum {
+  return Data.from(cbor, VaultVaultSpendDatumSchema as unknown as VaultVaultSpendDatum);
+}
+
+export const VaultVaultSpendRedeemerSchema = Vault_ActionSchema;
+export type VaultVaultSpendRedeemer = Data.Static<typeof VaultVaultSpendRedeemerSchema>;
+/** Encodes a redeemer of vault.vault.spend as CBOR hex. */
+export function encodeVaultVaultSpendRedeemer(value: VaultVaultSpendRedeemer): string {
+  return Data.to(value, VaultVaultSpendRedeemerSchema as unknown as VaultVaultSpendRedeemer);
+}
+/** Decodes a redeemer of vault.vault.spend from CBOR hex. */
+export function decodeVaultVaultSpendRedeemer(cbor: string): VaultVaultSpendRedeemer {
+  return Data.from(cbor, VaultVaultSpendRedeemerSchema as unknown as VaultVaultSpendRedeemer);
+}
+
@@ -0,0 +1,304 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprints sha256:880d283515169a5b15174993dd047ca33343db16ea604569e07b2a361a746765, sha256:e8a2cf5cc15c4933aa8ad58a04b3e51c91a81d33ef95f0bd299e8ef7b0e050af, sha256:a9cf1fb0a4f63d70e768adf45e33e1eb217dd997d51703596069112e67da4f9b.
+import { Data } from '@lucid-evolution/lucid';
+import * as plutusCommon from './plutus-common';
+
+// fillSchema completes a schema that was declared before the schemas it depends on.
+// Schemas built from the declaration may hold a shallow copy of it, so its constructor
+// list is updated in place, where those copies share it.
+function fillSchema(target: any, schema: any): void {
+  const anyOf = target.anyOf;
+  Object.assign(target, schema);
+  if (anyOf && schema.anyOf) {
+    anyOf.splice(0, anyOf.length, ...schema.anyOf);
+    target.anyOf = anyOf;
+  }
+}
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Data
+/**
+ * Any Plutus data.
+ */
+export const PlutusDataSchema = Data.Any();
+
+/**
+ * Any Plutus data.
+ */
+export type PlutusData = Data.Static<typeof PlutusDataSchema>;
+export const PlutusData = PlutusDataSchema as unknown as PlutusData;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for List$Pair$ByteArray_Int
+export const List_Pair_ByteArray_IntSchema = Data.Map(ByteArraySchema, IntSchema);
+
+export type List_Pair_ByteArray_Int = Data.Static<typeof List_Pair_ByteArray_IntSchema>;
+export const List_Pair_ByteArray_Int = List_Pair_ByteArray_IntSchema as unknown as List_Pair_ByteArray_Int;
+
+// -----------------------------
+// Schema for Opaque
+export const OpaqueSchema = Data.Any();
+
+export type Opaque = Data.Static<typeof OpaqueSchema>;
+export const Opaque = OpaqueSchema as unknown as Opaque;
+
+// -----------------------------
+// Schema for aiken/crypto/ScriptHash
+export const ScriptHashSchema = Data.Bytes();
+
+export type ScriptHash = Data.Static<typeof ScriptHashSchema>;
+export const ScriptHash = ScriptHashSchema as unknown as ScriptHash;
+
+// -----------------------------
+// Schema for aiken/crypto/VerificationKeyHash
+export const VerificationKeyHashSchema = Data.Bytes();
+
+export type VerificationKeyHash = Data.Static<typeof VerificationKeyHashSchema>;
+export const VerificationKeyHash = VerificationKeyHashSchema as unknown as VerificationKeyHash;
+
+// -----------------------------
+// Schema for cardano/address/Credential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const CredentialSchema = plutusCommon.CredentialSchema;
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type Credential = Data.Static<typeof CredentialSchema>;
+export const Credential = CredentialSchema as unknown as Credential;
+
+// -----------------------------
+// Schema for cardano/address/StakeCredential
+/**
+ * Represent a type of object that can be represented either inline (by hash) or via a reference (i.e. a pointer to an on-chain location).
+ */
+export const StakeCredentialSchema = plutusCommon.StakeCredentialSchema;
+
+/**
+ * Represent a type of object that can be represented either inline (by hash) or via a reference (i.e. a pointer to an on-chain location).
+ */
+export type StakeCredential = Data.Static<typeof StakeCredentialSchema>;
+export const StakeCredential = StakeCredentialSchema as unknown as StakeCredential;
+
+// -----------------------------
+// Schema for Option$cardano/address/StakeCredential
+export const OptionSchema = Data.Nullable(StakeCredentialSchema);
+
+export type Option = Data.Static<typeof OptionSchema>;
+export const Option = OptionSchema as unknown as Option;
+
+// -----------------------------
+// Schema for Owner
+/**
+ * An alias carrying its own title
+ */
+export const OwnerSchema = ByteArraySchema;
+
+/**
+ * An alias carrying its own title
+ */
+export type Owner = Data.Static<typeof OwnerSchema>;
+export const Owner = OwnerSchema as unknown as Owner;
+
+// -----------------------------
+// Schema for Pair$ByteArray_Int
+export const PairSchema = Data.Tuple([ByteArraySchema, IntSchema]);
+
+export type Pair = Data.Static<typeof PairSchema>;
+export const Pair = PairSchema as unknown as Pair;
+
+// -----------------------------
+// Schema for cardano/assets/PolicyId
+export const PolicyIdSchema = Data.Bytes();
+
+export type PolicyId = Data.Static<typeof PolicyIdSchema>;
+export const PolicyId = PolicyIdSchema as unknown as PolicyId;
+
+// -----------------------------
+// Schema for Pairs$cardano/assets/PolicyId_Int
+export const Pairs_PolicyId__Int_Schema = Data.Map(PolicyIdSchema, IntSchema);
+
+export type Pairs_PolicyId__Int_ = Data.Static<typeof Pairs_PolicyId__Int_Schema>;
+export const Pairs_PolicyId__Int_ = Pairs_PolicyId__Int_Schema as unknown as Pairs_PolicyId__Int_;
+
+// -----------------------------
+// Schema for String
+export const PlutusStringSchema = Data.Bytes();
+
+export type PlutusString = Data.Static<typeof PlutusStringSchema>;
+export const PlutusString = PlutusStringSchema as unknown as PlutusString;
+
+// -----------------------------
+// Schema for Tuple$Int_Int
+export const TupleSchema = Data.Tuple([IntSchema, IntSchema]);
+
+export type Tuple = Data.Static<typeof TupleSchema>;
+export const Tuple = TupleSchema as unknown as Tuple;
+
+// -----------------------------
+// Schema for cardano/address/PaymentCredential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const PaymentCredentialSchema = plutusCommon.CredentialSchema;
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type PaymentCredential = Data.Static<typeof PaymentCredentialSchema>;
+export const PaymentCredential = PaymentCredentialSchema as unknown as PaymentCredential;
+
+// -----------------------------
+// Schema for cardano/address/Address
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export const AddressSchema = plutusCommon.AddressSchema;
+
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export type Address = Data.Static<typeof AddressSchema>;
+export const Address = AddressSchema as unknown as Address;
+
+// -----------------------------
+// Schema for common/Deadline
+/**
+ * POSIX time in milliseconds
+ */
+export const DeadlineSchema = IntSchema;
+
+/**
+ * POSIX time in milliseconds
+ */
+export type Deadline = Data.Static<typeof DeadlineSchema>;
+export const Deadline = DeadlineSchema as unknown as Deadline;
+
+// -----------------------------
+// Forward declarations of the recursive schemas common/Signers
+export const SignersSchema: any = { anyOf: [] };
+
+// -----------------------------
+// Schema for common/Signers
+fillSchema(SignersSchema, Data.Enum([Data.Object({ Signature: Data.Tuple([VerificationKeyHashSchema]) }), Data.Object({ AllOf: Data.Tuple([SignersSchema, SignersSchema]) }), Data.Object({ Before: Data.Tuple([DeadlineSchema]) })]));
+
+/**
+ * Multisig policy shared by every project
+ */
+export type Signers = Data.Static<typeof SignersSchema>;
+export const Signers = SignersSchema as unknown as Signers;
+
+// -----------------------------
+// Schema for escrow/Escrow
+export const EscrowSchema = Data.Object({ beneficiary: ByteArraySchema, release: SignersSchema, refund_after: DeadlineSchema });
+
+export type Escrow = Data.Static<typeof EscrowSchema>;
+export const Escrow = EscrowSchema as unknown as Escrow;
+
+// -----------------------------
+// Schema for market/Action
+/**
+ * What the spender of a listing wants to do
+ *
+ * - `Buy`: Pay the seller and take the item
+ */
+export const ActionSchema = Data.Enum([Data.Literal("Buy"), Data.Literal("Cancel"), Data.Object({ /** Change the asking price - `new_price`: Replaces the listing's price */ Update: Data.Tuple([IntSchema]) })]);
+
+/**
+ * What the spender of a listing wants to do
+ *
+ * - `Buy`: Pay the seller and take the item
+ */
+export type Action = Data.Static<typeof ActionSchema>;
+export const Action = ActionSchema as unknown as Action;
+
+// -----------------------------
+// Schema for market/Listing
+/**
+ * An item for sale.
+ *
+ * The seller is paid `price` lovelace when the listing is bought.
+ */
+export const ListingSchema = Data.Object({ /** Who receives the payment */ seller: AddressSchema, /** Asking price in lovelace */ price: IntSchema, royalty: PairSchema, /** Marketplace fees per policy, paid on top of the price */ fees: Data.Map(ByteArraySchema, IntSchema) });
+
+/**
+ * An item for sale.
+ *
+ * The seller is paid `price` lovelace when the listing is bought.
+ */
+export type Listing = Data.Static<typeof ListingSchema>;
+export const Listing = ListingSchema as unknown as Listing;
+
+// -----------------------------
+// Schema for market/MintAction
+export const MintActionSchema = Data.Enum([Data.Object({ Mint: Data.Tuple([Data.Map(PolicyIdSchema, IntSchema)]) }), Data.Literal("Burn")]);
+
+export type MintAction = Data.Static<typeof MintActionSchema>;
+export const MintAction = MintActionSchema as unknown as MintAction;
+
+// -----------------------------
+// Schema for oracle/FeedRedeemer
+export const FeedRedeemerSchema = Data.Enum([Data.Object({ /** Publish a new price - `window`: Validity window as lower and upper POSIX time */ Publish: Data.Tuple([IntSchema, IntSchema, TupleSchema]) }), Data.Literal("Retire")]);
+
+export type FeedRedeemer = Data.Static<typeof FeedRedeemerSchema>;
+export const FeedRedeemer = FeedRedeemerSchema as unknown as FeedRedeemer;
+
+// -----------------------------
+// Schema for vault/Action$Deposit$amount
+export const AmountSchema = Data.Integer();
+
+export type Amount = Data.Static<typeof AmountSchema>;
+export const Amount = AmountSchema as unknown as Amount;
+
+// -----------------------------
+// Schema for vault/Action
+export const Vault_ActionSchema = Data.Enum([Data.Object({ Deposit: Data.Tuple([AmountSchema]) }), Data.Object({ /** - `to`: Recipient of the funds */ Withdraw: Data.Tuple([OwnerSchema]) })]);
+
+export type Vault_Action = Data.Static<typeof Vault_ActionSchema>;
+export const Vault_Action = Vault_ActionSchema as unknown as Vault_Action;
+
+// -----------------------------
+// Schema for vault/Vault$limits
+export const LimitsSchema = Data.Map(ByteArraySchema, IntSchema);
+
+export type Limits = Data.Static<typeof LimitsSchema>;
+export const Limits = LimitsSchema as unknown as Limits;
+
+// -----------------------------
+// Schema for vault/Vault$memo
+export const MemoSchema = Data.Bytes();
+
+export type Memo = Data.Static<typeof MemoSchema>;
+export const Memo = MemoSchema as unknown as Memo;
+
+// -----------------------------
+// Schema for vault/Vault$mode
+export const ModeSchema = Data.Enum([Data.Literal("Open"), Data.Literal("Locked")]);
+
+export type Mode = Data.Static<typeof ModeSchema>;
+export const Mode = ModeSchema as unknown as Mode;
+
+// -----------------------------
+// Schema for vault/Vault
+export const VaultSchema = Data.Object({ owner: OwnerSchema, limits: LimitsSchema, mode: ModeSchema, memo: MemoSchema });
+
+export type Vault = Data.Static<typeof VaultSchema>;
+export const Vault = VaultSchema as unknown as Vault;
+
@@ -0,0 +1,40 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:e8a2cf5cc15c4933aa8ad58a04b3e51c91a81d33ef95f0bd299e8ef7b0e050af.
+import { Data } from '@lucid-evolution/lucid';
+import { EscrowSchema, SignersSchema } from './plutus-types';
+
+// -----------------------------
+// Validator escrow.escrow.spend
+export const EscrowEscrowSpendValidator = {
+  title: "escrow.escrow.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  datum: EscrowSchema,
+  redeemer: SignersSchema,
+} as const;
+
+export const EscrowEscrowSpendDatumSchema = EscrowSchema;
+export type EscrowEscrowSpendDatum = Data.Static<typeof EscrowEscrowSpendDatumSchema>;
+/** Encodes a datum of escrow.escrow.spend as CBOR hex. */
+export function encodeEscrowEscrowSpendDatum(value: EscrowEscrowSpendDatum): string {
+  return Data.to(value, EscrowEscrowSpendDatumSchema as unknown as EscrowEscrowSpendDatum);
+}
+/** Decodes a datum of escrow.escrow.spend from CBOR hex. */
+export function decodeEscrowEscrowSpendDatum(cbor: string): EscrowEscrowSpendDatum {
+  return Data.from(cbor, EscrowEscrowSpendDatumSchema as unknown as EscrowEscrowSpendDatum);
+}
+
+export const EscrowEscrowSpendRedeemerSchema = SignersSchema;
+export type EscrowEscrowSpendRedeemer = Data.Static<typeof EscrowEscrowSpendRedeemerSchema>;
+/** Encodes a redeemer of escrow.escrow.spend as CBOR hex. */
+export function encodeEscrowEscrowSpendRedeemer(value: EscrowEscrowSpendRedeemer): string {
+  return Data.to(value, EscrowEscrowSpendRedeemerSchema as unknown as EscrowEscrowSpendRedeemer);
+}
+/** Decodes a redeemer of escrow.escrow.spend from CBOR hex. */
+export function decodeEscrowEscrowSpendRedeemer(cbor: string): EscrowEscrowSpendRedeemer {
+  return Data.from(cbor, EscrowEscrowSpendRedeemerSchema as unknown as EscrowEscrowSpendRedeemer);
+}
+
@@ -0,0 +1,104 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:880d283515169a5b15174993dd047ca33343db16ea604569e07b2a361a746765.
+import { Data, applyParamsToScript } from '@lucid-evolution/lucid';
+import { ActionSchema, FeedRedeemerSchema, ListingSchema, MintActionSchema, PlutusStringSchema, VerificationKeyHashSchema } from './plutus-types';
+
+// -----------------------------
+// Validator market.listing.spend
+/**
+ * Holds items for sale until they are bought or cancelled.
+ *
+ * - Datum `listing`: The listing being spent
+ */
+export const MarketListingSpendValidator = {
+  title: "market.listing.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "581f01010025333573466e1cd55ce9baa357426ae88d55cf1baa00148000526161" },
+  hash: "99f61e3c23f13561c4b25069c4bd4819cdfd27fa92d57677dce676bc",
+  addresses: { mainnet: "addr1wxvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q3jr0qt", preprod: "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w", preview: "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w" },
+  datum: ListingSchema,
+  redeemer: ActionSchema,
+} as const;
+
+export const MarketListingSpendDatumSchema = ListingSchema;
+export type MarketListingSpendDatum = Data.Static<typeof MarketListingSpendDatumSchema>;
+/** Encodes a datum of market.listing.spend as CBOR hex. */
+export function encodeMarketListingSpendDatum(value: MarketListingSpendDatum): string {
+  return Data.to(value, MarketListingSpendDatumSchema as unknown as MarketListingSpendDatum);
+}
+/** Decodes a datum of market.listing.spend from CBOR hex. */
+export function decodeMarketListingSpendDatum(cbor: string): MarketListingSpendDatum {
+  return Data.from(cbor, MarketListingSpendDatumSchema as unknown as MarketListingSpendDatum);
+}
+
+export const MarketListingSpendRedeemerSchema = ActionSchema;
+export type MarketListingSpendRedeemer = Data.Static<typeof MarketListingSpendRedeemerSchema>;
+/** Encodes a redeemer of market.listing.spend as CBOR hex. */
+export function encodeMarketListingSpendRedeemer(value: MarketListingSpendRedeemer): string {
+  return Data.to(value, MarketListingSpendRedeemerSchema as unknown as MarketListingSpendRedeemer);
+}
+/** Decodes a redeemer of market.listing.spend from CBOR hex. */
+export function decodeMarketListingSpendRedeemer(cbor: string): MarketListingSpendRedeemer {
+  return Data.from(cbor, MarketListingSpendRedeemerSchema as unknown as MarketListingSpendRedeemer);
+}
+
+// -----------------------------
+// Validator market.listing.mint
+export const MarketListingMintValidator = {
+  title: "market.listing.mint",
+  purpose: "mint",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  policyId: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  redeemer: MintActionSchema,
+} as const;
+
+export const MarketListingMintRedeemerSchema = MintActionSchema;
+export type MarketListingMintRedeemer = Data.Static<typeof MarketListingMintRedeemerSchema>;
+/** Encodes a redeemer of market.listing.mint as CBOR hex. */
+export function encodeMarketListingMintRedeemer(value: MarketListingMintRedeemer): string {
+  return Data.to(value, MarketListingMintRedeemerSchema as unknown as MarketListingMintRedeemer);
+}
+/** Decodes a redeemer of market.listing.mint from CBOR hex. */
+export function decodeMarketListingMintRedeemer(cbor: string): MarketListingMintRedeemer {
+  return Data.from(cbor, MarketListingMintRedeemerSchema as unknown as MarketListingMintRedeemer);
+}
+
+// -----------------------------
+// Validator oracle.feed.withdraw
+export const OracleFeedWithdrawParamsSchema = Data.Tuple([VerificationKeyHashSchema, PlutusStringSchema, Data.Integer()]);
+export type OracleFeedWithdrawParams = Data.Static<typeof OracleFeedWithdrawParamsSchema>;
+/**
+ * Price oracle whose owner publishes prices by withdrawing.
+ *
+ * - Parameter `owner`: Key allowed to publish prices
+ * - Parameter `feed_name`: Human-readable feed name
+ * - Parameter `decimals`: Number of decimals in published prices
+ */
+export const OracleFeedWithdrawValidator = {
+  title: "oracle.feed.withdraw",
+  purpose: "withdraw",
+  script: { type: "PlutusV3", script: "4701010022224981" },
+  hash: "8584a137ba44ecf36aee085b7da213a1825c288743a0a479851905e8",
+  redeemer: FeedRedeemerSchema,
+  parameters: [VerificationKeyHashSchema, PlutusStringSchema, Data.Integer()],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: OracleFeedWithdrawParams) => ({
+    type: "PlutusV3" as const,
+    script: applyParamsToScript("4701010022224981", params, OracleFeedWithdrawParamsSchema as unknown as OracleFeedWithdrawParams),
+  }),
+} as const;
+
+export const OracleFeedWithdrawRedeemerSchema = FeedRedeemerSchema;
+export type OracleFeedWithdrawRedeemer = Data.Static<typeof OracleFeedWithdrawRedeemerSchema>;
+/** Encodes a redeemer of oracle.feed.withdraw as CBOR hex. */
+export function encodeOracleFeedWithdrawRedeemer(value: OracleFeedWithdrawRedeemer): string {
+  return Data.to(value, OracleFeedWithdrawRedeemerSchema as unknown as OracleFeedWithdrawRedeemer);
+}
+/** Decodes a redeemer of oracle.feed.withdraw from CBOR hex. */
+export function decodeOracleFeedWithdrawRedeemer(cbor: string): OracleFeedWithdrawRedeemer {
+  return Data.from(cbor, OracleFeedWithdrawRedeemerSchema as unknown as OracleFeedWithdrawRedeemer);
+}
+
@@ -0,0 +1,159 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:10c35312c8d1250f50e8a646a4a8d67410125aebbacb7e4fe339f75e58050e88.
+import { Data } from '@lucid-evolution/lucid';
+
+// fillSchema completes a schema that was declared before the schemas it depends on.
+// Schemas built from the declaration may hold a shallow copy of it, so its constructor
+// list is updated in place, where those copies share it.
+function fillSchema(target: any, schema: any): void {
+  const anyOf = target.anyOf;
+  Object.assign(target, schema);
+  if (anyOf && schema.anyOf) {
+    anyOf.splice(0, anyOf.length, ...schema.anyOf);
+    target.anyOf = anyOf;
+  }
+}
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Forward declarations of the recursive schemas Option$expr/Statement, expr/Binding, expr/Expr, expr/Statement
+export const OptionSchema: any = { anyOf: [] };
+export const BindingSchema: any = { anyOf: [] };
+export const ExprSchema: any = { anyOf: [] };
+export const StatementSchema: any = { anyOf: [] };
+
+// -----------------------------
+// Schema for Option$expr/Statement
+fillSchema(OptionSchema, Data.Nullable(StatementSchema));
+
+/**
+ * - `None`: Nothing.
+ */
+export type Option = Data.Static<typeof OptionSchema>;
+export const Option = OptionSchema as unknown as Option;
+
+// -----------------------------
+// Schema for expr/Binding
+fillSchema(BindingSchema, Data.Object({ name: ByteArraySchema, value: ExprSchema }));
+
+export type Binding = Data.Static<typeof BindingSchema>;
+export const Binding = BindingSchema as unknown as Binding;
+
+// -----------------------------
+// Schema for expr/Expr
+fillSchema(ExprSchema, Data.Enum([Data.Object({ Lit: Data.Tuple([IntSchema]) }), Data.Object({ Add: Data.Tuple([ExprSchema, ExprSchema]) }), Data.Object({ Let: Data.Tuple([BindingSchema, ExprSchema]) }), Data.Object({ Block: Data.Tuple([Data.Array(StatementSchema)]) })]));
+
+export type Expr = Data.Static<typeof ExprSchema>;
+export const Expr = ExprSchema as unknown as Expr;
+
+// -----------------------------
+// Schema for expr/Statement
+fillSchema(StatementSchema, Data.Object({ expr: ExprSchema, next: OptionSchema }));
+
+export type Statement = Data.Static<typeof StatementSchema>;
+export const Statement = StatementSchema as unknown as Statement;
+
+// -----------------------------
+// Schema for List$expr/Statement
+export const List_expr_StatementSchema = Data.Array(StatementSchema);
+
+export type List_expr_Statement = Data.Static<typeof List_expr_StatementSchema>;
+export const List_expr_Statement = List_expr_StatementSchema as unknown as List_expr_Statement;
+
+// -----------------------------
+// Forward declarations of the recursive schemas Option$json/Rose, json/Rose
+export const Option_json_RoseSchema: any = { anyOf: [] };
+
+// -----------------------------
+// Schema for json/Rose
+export const RoseSchema = Data.Array(Option_json_RoseSchema);
+
+/**
+ * A rose tree whose children are again rose trees
+ */
+export type Rose = Data.Static<typeof RoseSchema>;
+export const Rose = RoseSchema as unknown as Rose;
+
+// -----------------------------
+// Schema for Option$json/Rose
+fillSchema(Option_json_RoseSchema, Data.Nullable(RoseSchema));
+
+/**
+ * - `None`: Nothing.
+ */
+export type Option_json_Rose = Data.Static<typeof Option_json_RoseSchema>;
+export const Option_json_Rose = Option_json_RoseSchema as unknown as Option_json_Rose;
+
+// -----------------------------
+// Forward declarations of the recursive schemas json/Fields, json/Value
+export const ValueSchema: any = { anyOf: [] };
+
+// -----------------------------
+// Schema for json/Fields
+export const FieldsSchema = Data.Map(ByteArraySchema, ValueSchema);
+
+export type Fields = Data.Static<typeof FieldsSchema>;
+export const Fields = FieldsSchema as unknown as Fields;
+
+// -----------------------------
+// Schema for json/Value
+fillSchema(ValueSchema, Data.Enum([Data.Object({ Object: Data.Tuple([FieldsSchema]) }), Data.Object({ Array: Data.Tuple([Data.Array(ValueSchema)]) }), Data.Object({ Number: Data.Tuple([IntSchema]) }), Data.Object({ Tree: Data.Tuple([RoseSchema]) })]));
+
+export type Value = Data.Static<typeof ValueSchema>;
+export const Value = ValueSchema as unknown as Value;
+
+// -----------------------------
+// Schema for List$json/Value
+export const List_json_ValueSchema = Data.Array(ValueSchema);
+
+export type List_json_Value = Data.Static<typeof List_json_ValueSchema>;
+export const List_json_Value = List_json_ValueSchema as unknown as List_json_Value;
+
+// -----------------------------
+// Validator calculator.calculator.spend
+export const CalculatorCalculatorSpendValidator = {
+  title: "calculator.calculator.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  datum: ValueSchema,
+  redeemer: ExprSchema,
+} as const;
+
+export const CalculatorCalculatorSpendDatumSchema = ValueSchema;
+export type CalculatorCalculatorSpendDatum = Data.Static<typeof CalculatorCalculatorSpendDatumSchema>;
+/** Encodes a datum of calculator.calculator.spend as CBOR hex. */
+export function encodeCalculatorCalculatorSpendDatum(value: CalculatorCalculatorSpendDatum): string {
+  return Data.to(value, CalculatorCalculatorSpendDatumSchema as unknown as CalculatorCalculatorSpendDatum);
+}
+/** Decodes a datum of calculator.calculator.spend from CBOR hex. */
+export function decodeCalculatorCalculatorSpendDatum(cbor: string): CalculatorCalculatorSpendDatum {
+  return Data.from(cbor, CalculatorCalculatorSpendDatumSchema as unknown as CalculatorCalculatorSpendDatum);
+}
+
+export const CalculatorCalculatorSpendRedeemerSchema = ExprSchema;
+export type CalculatorCalculatorSpendRedeemer = Data.Static<typeof CalculatorCalculatorSpendRedeemerSchema>;
+/** Encodes a redeemer of calculator.calculator.spend as CBOR hex. */
+export function encodeCalculatorCalculatorSpendRedeemer(value: CalculatorCalculatorSpendRedeemer): string {
+  return Data.to(value, CalculatorCalculatorSpendRedeemerSchema as unknown as CalculatorCalculatorSpendRedeemer);
+}
+/** Decodes a redeemer of calculator.calculator.spend from CBOR hex. */
+export function decodeCalculatorCalculatorSpendRedeemer(cbor: string): CalculatorCalculatorSpendRedeemer {
+  return Data.from(cbor, CalculatorCalculatorSpendRedeemerSchema as unknown as CalculatorCalculatorSpendRedeemer);
+}
+
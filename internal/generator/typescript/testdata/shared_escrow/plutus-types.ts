@@ -0,0 +1,106 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:e8a2cf5cc15c4933aa8ad58a04b3e51c91a81d33ef95f0bd299e8ef7b0e050af.
+import { Data } from '@lucid-evolution/lucid';
+
+// fillSchema completes a schema that was declared before the schemas it depends on.
+// Schemas built from the declaration may hold a shallow copy of it, so its constructor
+// list is updated in place, where those copies share it.
+function fillSchema(target: any, schema: any): void {
+  const anyOf = target.anyOf;
+  Object.assign(target, schema);
+  if (anyOf && schema.anyOf) {
+    anyOf.splice(0, anyOf.length, ...schema.anyOf);
+    target.anyOf = anyOf;
+  }
+}
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for aiken/crypto/VerificationKeyHash
+export const VerificationKeyHashSchema = Data.Bytes();
+
+export type VerificationKeyHash = Data.Static<typeof VerificationKeyHashSchema>;
+export const VerificationKeyHash = VerificationKeyHashSchema as unknown as VerificationKeyHash;
+
+// -----------------------------
+// Schema for common/Deadline
+/**
+ * POSIX time in milliseconds
+ */
+export const DeadlineSchema = IntSchema;
+
+/**
+ * POSIX time in milliseconds
+ */
+export type Deadline = Data.Static<typeof DeadlineSchema>;
+export const Deadline = DeadlineSchema as unknown as Deadline;
+
+// -----------------------------
+// Forward declarations of the recursive schemas common/Signers
+export const SignersSchema: any = { anyOf: [] };
+
+// -----------------------------
+// Schema for common/Signers
+fillSchema(SignersSchema, Data.Enum([Data.Object({ Signature: Data.Tuple([VerificationKeyHashSchema]) }), Data.Object({ AllOf: Data.Tuple([SignersSchema, SignersSchema]) }), Data.Object({ Before: Data.Tuple([DeadlineSchema]) })]));
+
+/**
+ * Multisig policy shared by every project
+ */
+export type Signers = Data.Static<typeof SignersSchema>;
+export const Signers = SignersSchema as unknown as Signers;
+
+// -----------------------------
+// Schema for escrow/Escrow
+export const EscrowSchema = Data.Object({ beneficiary: ByteArraySchema, release: SignersSchema, refund_after: DeadlineSchema });
+
+export type Escrow = Data.Static<typeof EscrowSchema>;
+export const Escrow = EscrowSchema as unknown as Escrow;
+
+// -----------------------------
+// Validator escrow.escrow.spend
+export const EscrowEscrowSpendValidator = {
+  title: "escrow.escrow.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  datum: EscrowSchema,
+  redeemer: SignersSchema,
+} as const;
+
+export const EscrowEscrowSpendDatumSchema = EscrowSchema;
+export type EscrowEscrowSpendDatum = Data.Static<typeof EscrowEscrowSpendDatumSchema>;
+/** Encodes a datum of escrow.escrow.spend as CBOR hex. */
+export function encodeEscrowEscrowSpendDatum(value: EscrowEscrowSpendDatum): string {
+  return Data.to(value, EscrowEscrowSpendDatumSchema as unknown as EscrowEscrowSpendDatum);
+}
+/** Decodes a datum of escrow.escrow.spend from CBOR hex. */
+export function decodeEscrowEscrowSpendDatum(cbor: string): EscrowEscrowSpendDatum {
+  return Data.from(cbor, EscrowEscrowSpendDatumSchema as unknown as EscrowEscrowSpendDatum);
+}
+
+export const EscrowEscrowSpendRedeemerSchema = SignersSchema;
+export type EscrowEscrowSpendRedeemer = Data.Static<typeof EscrowEscrowSpendRedeemerSchema>;
+/** Encodes a redeemer of escrow.escrow.spend as CBOR hex. */
+export function encodeEscrowEscrowSpendRedeemer(value: EscrowEscrowSpendRedeemer): string {
+  return Data.to(value, EscrowEscrowSpendRedeemerSchema as unknown as EscrowEscrowSpendRedeemer);
+}
+/** Decodes a redeemer of escrow.escrow.spend from CBOR hex. */
+export function decodeEscrowEscrowSpendRedeemer(cbor: string): EscrowEscrowSpendRedeemer {
+  return Data.from(cbor, EscrowEscrowSpendRedeemerSchema as unknown as EscrowEscrowSpendRedeemer);
+}
+
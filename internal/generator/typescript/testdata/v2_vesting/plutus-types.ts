@@ -0,0 +1,314 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:b6b46a93a2da367b65ab31768087079fdf34e945839ec9cfaadd3d9ae14ff3bc.
+import { Data, applyParamsToScript } from '@lucid-evolution/lucid';
+
+// -----------------------------
+// Schema for Bool
+export const BoolSchema = Data.Boolean();
+
+export type Bool = Data.Static<typeof BoolSchema>;
+export const Bool = BoolSchema as unknown as Bool;
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Data
+/**
+ * Any Plutus data.
+ */
+export const PlutusDataSchema = Data.Any();
+
+/**
+ * Any Plutus data.
+ */
+export type PlutusData = Data.Static<typeof PlutusDataSchema>;
+export const PlutusData = PlutusDataSchema as unknown as PlutusData;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for List$ByteArray
+export const List_ByteArraySchema = Data.Array(ByteArraySchema);
+
+export type List_ByteArray = Data.Static<typeof List_ByteArraySchema>;
+export const List_ByteArray = List_ByteArraySchema as unknown as List_ByteArray;
+
+// -----------------------------
+// Schema for aiken/hash/Hash$Blake2b_224_VerificationKey
+export const Aiken_hash_Hash_Blake2b_224_VerificationKeySchema = Data.Bytes();
+
+export type Aiken_hash_Hash_Blake2b_224_VerificationKey = Data.Static<typeof Aiken_hash_Hash_Blake2b_224_VerificationKeySchema>;
+export const Aiken_hash_Hash_Blake2b_224_VerificationKey = Aiken_hash_Hash_Blake2b_224_VerificationKeySchema as unknown as Aiken_hash_Hash_Blake2b_224_VerificationKey;
+
+// -----------------------------
+// Schema for List$aiken/hash/Hash$Blake2b_224_VerificationKey
+export const List_aiken_hash_Hash_Blake2b_224_VerificationKeySchema = Data.Array(Aiken_hash_Hash_Blake2b_224_VerificationKeySchema);
+
+export type List_aiken_hash_Hash_Blake2b_224_VerificationKey = Data.Static<typeof List_aiken_hash_Hash_Blake2b_224_VerificationKeySchema>;
+export const List_aiken_hash_Hash_Blake2b_224_VerificationKey = List_aiken_hash_Hash_Blake2b_224_VerificationKeySchema as unknown as List_aiken_hash_Hash_Blake2b_224_VerificationKey;
+
+// -----------------------------
+// Schema for Option$Int
+export const OptionalSchema = Data.Nullable(IntSchema);
+
+export type Optional = Data.Static<typeof OptionalSchema>;
+export const Optional = OptionalSchema as unknown as Optional;
+
+// -----------------------------
+// Schema for aiken/hash/Hash$Blake2b_224_Script
+export const HashSchema = Data.Bytes();
+
+export type Hash = Data.Static<typeof HashSchema>;
+export const Hash = HashSchema as unknown as Hash;
+
+// -----------------------------
+// Schema for aiken/transaction/credential/Credential
+export const CredentialSchema = Data.Enum([Data.Object({ VerificationKeyCredential: Data.Tuple([Aiken_hash_Hash_Blake2b_224_VerificationKeySchema]) }), Data.Object({ ScriptCredential: Data.Tuple([HashSchema]) })]);
+
+export type Credential = Data.Static<typeof CredentialSchema>;
+export const Credential = CredentialSchema as unknown as Credential;
+
+// -----------------------------
+// Schema for aiken/transaction/credential/Referenced$aiken/transaction/credential/Credential
+export const ReferencedSchema = Data.Enum([Data.Object({ Inline: Data.Tuple([CredentialSchema]) }), Data.Object({ Pointer: Data.Tuple([IntSchema, IntSchema, IntSchema]) })]);
+
+export type Referenced = Data.Static<typeof ReferencedSchema>;
+export const Referenced = ReferencedSchema as unknown as Referenced;
+
+// -----------------------------
+// Schema for Option$aiken/transaction/credential/Referenced$aiken/transaction/credential/Credential
+export const Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_CredentialSchema = Data.Nullable(ReferencedSchema);
+
+export type Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_Credential = Data.Static<typeof Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_CredentialSchema>;
+export const Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_Credential = Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_CredentialSchema as unknown as Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_Credential;
+
+// -----------------------------
+// Schema for aiken/dict/Dict$ByteArray_Int
+export const DictSchema = Data.Map(ByteArraySchema, IntSchema);
+
+export type Dict = Data.Static<typeof DictSchema>;
+export const Dict = DictSchema as unknown as Dict;
+
+// -----------------------------
+// Schema for aiken/dict/Dict$ByteArray_aiken/dict/Dict$ByteArray_Int
+export const Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_IntSchema = Data.Map(ByteArraySchema, DictSchema);
+
+export type Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_Int = Data.Static<typeof Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_IntSchema>;
+export const Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_Int = Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_IntSchema as unknown as Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_Int;
+
+// -----------------------------
+// Schema for aiken/hash/Hash$Blake2b_256_Transaction
+export const Aiken_hash_Hash_Blake2b_256_TransactionSchema = Data.Bytes();
+
+export type Aiken_hash_Hash_Blake2b_256_Transaction = Data.Static<typeof Aiken_hash_Hash_Blake2b_256_TransactionSchema>;
+export const Aiken_hash_Hash_Blake2b_256_Transaction = Aiken_hash_Hash_Blake2b_256_TransactionSchema as unknown as Aiken_hash_Hash_Blake2b_256_Transaction;
+
+// -----------------------------
+// Schema for aiken/interval/IntervalBoundType$Int
+export const IntervalBoundTypeSchema = Data.Enum([Data.Literal("NegativeInfinity"), Data.Object({ Finite: Data.Tuple([IntSchema]) }), Data.Literal("PositiveInfinity")]);
+
+export type IntervalBoundType = Data.Static<typeof IntervalBoundTypeSchema>;
+export const IntervalBoundType = IntervalBoundTypeSchema as unknown as IntervalBoundType;
+
+// -----------------------------
+// Schema for aiken/interval/IntervalBound$Int
+export const IntervalBoundSchema = Data.Object({ bound_type: IntervalBoundTypeSchema, is_inclusive: BoolSchema });
+
+export type IntervalBound = Data.Static<typeof IntervalBoundSchema>;
+export const IntervalBound = IntervalBoundSchema as unknown as IntervalBound;
+
+// -----------------------------
+// Schema for aiken/interval/Interval$Int
+export const IntervalSchema = Data.Object({ lower_bound: IntervalBoundSchema, upper_bound: IntervalBoundSchema });
+
+export type Interval = Data.Static<typeof IntervalSchema>;
+export const Interval = IntervalSchema as unknown as Interval;
+
+// -----------------------------
+// Schema for aiken/transaction/TransactionId
+/**
+ * A unique transaction identifier, as the hash of a transaction body.
+ */
+export const TransactionIdSchema = Data.Object({ hash: Aiken_hash_Hash_Blake2b_256_TransactionSchema });
+
+/**
+ * A unique transaction identifier, as the hash of a transaction body.
+ */
+export type TransactionId = Data.Static<typeof TransactionIdSchema>;
+export const TransactionId = TransactionIdSchema as unknown as TransactionId;
+
+// -----------------------------
+// Schema for aiken/transaction/OutputReference
+/**
+ * An `OutputReference` is a unique reference to an output on-chain.
+ */
+export const OutputReferenceSchema = Data.Object({ transaction_id: TransactionIdSchema, output_index: IntSchema });
+
+/**
+ * An `OutputReference` is a unique reference to an output on-chain.
+ */
+export type OutputReference = Data.Static<typeof OutputReferenceSchema>;
+export const OutputReference = OutputReferenceSchema as unknown as OutputReference;
+
+// -----------------------------
+// Schema for aiken/transaction/credential/PaymentCredential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const PaymentCredentialSchema = Data.Enum([Data.Object({ VerificationKeyCredential: Data.Tuple([Aiken_hash_Hash_Blake2b_224_VerificationKeySchema]) }), Data.Object({ ScriptCredential: Data.Tuple([HashSchema]) })]);
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type PaymentCredential = Data.Static<typeof PaymentCredentialSchema>;
+export const PaymentCredential = PaymentCredentialSchema as unknown as PaymentCredential;
+
+// -----------------------------
+// Schema for aiken/transaction/credential/Address
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export const AddressSchema = Data.Object({ payment_credential: PaymentCredentialSchema, stake_credential: Option_aiken_transaction_credential_Referenced_aiken_transaction_credential_CredentialSchema });
+
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export type Address = Data.Static<typeof AddressSchema>;
+export const Address = AddressSchema as unknown as Address;
+
+// -----------------------------
+// Schema for gift_card/Action
+export const ActionSchema = Data.Enum([Data.Literal("Mint"), Data.Literal("Burn")]);
+
+export type Action = Data.Static<typeof ActionSchema>;
+export const Action = ActionSchema as unknown as Action;
+
+// -----------------------------
+// Schema for vesting/Datum
+export const DatumSchema = Data.Object({ /** Receives the vested funds. */ beneficiary: Aiken_hash_Hash_Blake2b_224_VerificationKeySchema, owner: AddressSchema, schedule: IntervalSchema, cliff: OptionalSchema, witnesses: Data.Array(Aiken_hash_Hash_Blake2b_224_VerificationKeySchema), allocations: Aiken_dict_Dict_ByteArray_aiken_dict_Dict_ByteArray_IntSchema });
+
+export type Datum = Data.Static<typeof DatumSchema>;
+export const Datum = DatumSchema as unknown as Datum;
+
+// -----------------------------
+// Schema for vesting/Redeemer
+export const RedeemerSchema = Data.Enum([Data.Object({ Claim: Data.Tuple([IntSchema]) }), Data.Literal("Cancel"), Data.Object({ /** Moves the end of the schedule. */ Extend: Data.Tuple([IntSchema]) })]);
+
+export type Redeemer = Data.Static<typeof RedeemerSchema>;
+export const Redeemer = RedeemerSchema as unknown as Redeemer;
+
+// -----------------------------
+// Validator vesting.vesting
+export const VestingVestingValidator = {
+  title: "vesting.vesting",
+  script: { type: "PlutusV2", script: "4a01000022232499201601" },
+  hash: "c43beef9646915703ee26686d84f757530d73d17c5f2d96105ce0e25",
+  addresses: { mainnet: "addr1w8zrhmhev3532up7ufngdkz0w46np4eazlzl9ktpqh8qufg3y6298", preprod: "addr_test1wrzrhmhev3532up7ufngdkz0w46np4eazlzl9ktpqh8qufg2vwk2z", preview: "addr_test1wrzrhmhev3532up7ufngdkz0w46np4eazlzl9ktpqh8qufg2vwk2z" },
+  datum: DatumSchema,
+  redeemer: RedeemerSchema,
+} as const;
+
+export const VestingVestingDatumSchema = DatumSchema;
+export type VestingVestingDatum = Data.Static<typeof VestingVestingDatumSchema>;
+/** Encodes a datum of vesting.vesting as CBOR hex. */
+export function encodeVestingVestingDatum(value: VestingVestingDatum): string {
+  return Data.to(value, VestingVestingDatumSchema as unknown as VestingVestingDatum);
+}
+/** Decodes a datum of vesting.vesting from CBOR hex. */
+export function decodeVestingVestingDatum(cbor: string): VestingVestingDatum {
+  return Data.from(cbor, VestingVestingDatumSchema as unknown as VestingVestingDatum);
+}
+
+export const VestingVestingRedeemerSchema = RedeemerSchema;
+export type VestingVestingRedeemer = Data.Static<typeof VestingVestingRedeemerSchema>;
+/** Encodes a redeemer of vesting.vesting as CBOR hex. */
+export function encodeVestingVestingRedeemer(value: VestingVestingRedeemer): string {
+  return Data.to(value, VestingVestingRedeemerSchema as unknown as VestingVestingRedeemer);
+}
+/** Decodes a redeemer of vesting.vesting from CBOR hex. */
+export function decodeVestingVestingRedeemer(cbor: string): VestingVestingRedeemer {
+  return Data.from(cbor, VestingVestingRedeemerSchema as unknown as VestingVestingRedeemer);
+}
+
+// -----------------------------
+// Validator gift_card.gift_card
+export const GiftCardGiftCardParamsSchema = Data.Tuple([ByteArraySchema, OutputReferenceSchema]);
+export type GiftCardGiftCardParams = Data.Static<typeof GiftCardGiftCardParamsSchema>;
+/**
+ * - Parameter `utxo_ref`: The output consumed when minting, making the policy one-shot
+ */
+export const GiftCardGiftCardValidator = {
+  title: "gift_card.gift_card",
+  script: { type: "PlutusV2", script: "4b0100002222232499201801" },
+  hash: "5b193dbada74b85dc0a549c0fdb33a0f3e4543c8bf59f1122b5d3d15",
+  redeemer: ActionSchema,
+  parameters: [ByteArraySchema, OutputReferenceSchema],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: GiftCardGiftCardParams) => ({
+    type: "PlutusV2" as const,
+    script: applyParamsToScript("4b0100002222232499201801", params, GiftCardGiftCardParamsSchema as unknown as GiftCardGiftCardParams),
+  }),
+} as const;
+
+export const GiftCardGiftCardRedeemerSchema = ActionSchema;
+export type GiftCardGiftCardRedeemer = Data.Static<typeof GiftCardGiftCardRedeemerSchema>;
+/** Encodes a redeemer of gift_card.gift_card as CBOR hex. */
+export function encodeGiftCardGiftCardRedeemer(value: GiftCardGiftCardRedeemer): string {
+  return Data.to(value, GiftCardGiftCardRedeemerSchema as unknown as GiftCardGiftCardRedeemer);
+}
+/** Decodes a redeemer of gift_card.gift_card from CBOR hex. */
+export function decodeGiftCardGiftCardRedeemer(cbor: string): GiftCardGiftCardRedeemer {
+  return Data.from(cbor, GiftCardGiftCardRedeemerSchema as unknown as GiftCardGiftCardRedeemer);
+}
+
+// -----------------------------
+// Validator gift_card.redeem
+export const GiftCardRedeemParamsSchema = Data.Tuple([ByteArraySchema, OutputReferenceSchema]);
+export type GiftCardRedeemParams = Data.Static<typeof GiftCardRedeemParamsSchema>;
+export const GiftCardRedeemValidator = {
+  title: "gift_card.redeem",
+  script: { type: "PlutusV2", script: "4b0100002222232499201801" },
+  hash: "5b193dbada74b85dc0a549c0fdb33a0f3e4543c8bf59f1122b5d3d15",
+  datum: PlutusDataSchema,
+  redeemer: PlutusDataSchema,
+  parameters: [ByteArraySchema, OutputReferenceSchema],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: GiftCardRedeemParams) => ({
+    type: "PlutusV2" as const,
+    script: applyParamsToScript("4b0100002222232499201801", params, GiftCardRedeemParamsSchema as unknown as GiftCardRedeemParams),
+  }),
+} as const;
+
+export const GiftCardRedeemDatumSchema = PlutusDataSchema;
+export type GiftCardRedeemDatum = Data.Static<typeof GiftCardRedeemDatumSchema>;
+/** Encodes a datum of gift_card.redeem as CBOR hex. */
+export function encodeGiftCardRedeemDatum(value: GiftCardRedeemDatum): string {
+  return Data.to(value, GiftCardRedeemDatumSchema as unknown as GiftCardRedeemDatum);
+}
+/** Decodes a datum of gift_card.redeem from CBOR hex. */
+export function decodeGiftCardRedeemDatum(cbor: string): GiftCardRedeemDatum {
+  return Data.from(cbor, GiftCardRedeemDatumSchema as unknown as GiftCardRedeemDatum);
+}
+
+export const GiftCardRedeemRedeemerSchema = PlutusDataSchema;
+export type GiftCardRedeemRedeemer = Data.Static<typeof GiftCardRedeemRedeemerSchema>;
+/** Encodes a redeemer of gift_card.redeem as CBOR hex. */
+export function encodeGiftCardRedeemRedeemer(value: GiftCardRedeemRedeemer): string {
+  return Data.to(value, GiftCardRedeemRedeemerSchema as unknown as GiftCardRedeemRedeemer);
+}
+/** Decodes a redeemer of gift_card.redeem from CBOR hex. */
+export function decodeGiftCardRedeemRedeemer(cbor: string): GiftCardRedeemRedeemer {
+  return Data.from(cbor, GiftCardRedeemRedeemerSchema as unknown as GiftCardRedeemRedeemer);
+}
+
@@ -0,0 +1,294 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:880d283515169a5b15174993dd047ca33343db16ea604569e07b2a361a746765.
+import { Data, applyParamsToScript } from '@lucid-evolution/lucid';
+import * as plutusCommon from './plutus-common';
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Data
+/**
+ * Any Plutus data.
+ */
+export const PlutusDataSchema = Data.Any();
+
+/**
+ * Any Plutus data.
+ */
+export type PlutusData = Data.Static<typeof PlutusDataSchema>;
+export const PlutusData = PlutusDataSchema as unknown as PlutusData;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for List$Pair$ByteArray_Int
+export const List_Pair_ByteArray_IntSchema = Data.Map(ByteArraySchema, IntSchema);
+
+export type List_Pair_ByteArray_Int = Data.Static<typeof List_Pair_ByteArray_IntSchema>;
+export const List_Pair_ByteArray_Int = List_Pair_ByteArray_IntSchema as unknown as List_Pair_ByteArray_Int;
+
+// -----------------------------
+// Schema for aiken/crypto/ScriptHash
+export const ScriptHashSchema = Data.Bytes();
+
+export type ScriptHash = Data.Static<typeof ScriptHashSchema>;
+export const ScriptHash = ScriptHashSchema as unknown as ScriptHash;
+
+// -----------------------------
+// Schema for aiken/crypto/VerificationKeyHash
+export const VerificationKeyHashSchema = Data.Bytes();
+
+export type VerificationKeyHash = Data.Static<typeof VerificationKeyHashSchema>;
+export const VerificationKeyHash = VerificationKeyHashSchema as unknown as VerificationKeyHash;
+
+// -----------------------------
+// Schema for cardano/address/Credential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const CredentialSchema = plutusCommon.CredentialSchema;
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type Credential = Data.Static<typeof CredentialSchema>;
+export const Credential = CredentialSchema as unknown as Credential;
+
+// -----------------------------
+// Schema for cardano/address/StakeCredential
+/**
+ * Represent a type of object that can be represented either inline (by hash) or via a reference (i.e. a pointer to an on-chain location).
+ */
+export const StakeCredentialSchema = plutusCommon.StakeCredentialSchema;
+
+/**
+ * Represent a type of object that can be represented either inline (by hash) or via a reference (i.e. a pointer to an on-chain location).
+ */
+export type StakeCredential = Data.Static<typeof StakeCredentialSchema>;
+export const StakeCredential = StakeCredentialSchema as unknown as StakeCredential;
+
+// -----------------------------
+// Schema for Option$cardano/address/StakeCredential
+export const OptionSchema = Data.Nullable(StakeCredentialSchema);
+
+export type Option = Data.Static<typeof OptionSchema>;
+export const Option = OptionSchema as unknown as Option;
+
+// -----------------------------
+// Schema for Pair$ByteArray_Int
+export const PairSchema = Data.Tuple([ByteArraySchema, IntSchema]);
+
+export type Pair = Data.Static<typeof PairSchema>;
+export const Pair = PairSchema as unknown as Pair;
+
+// -----------------------------
+// Schema for cardano/assets/PolicyId
+export const PolicyIdSchema = Data.Bytes();
+
+export type PolicyId = Data.Static<typeof PolicyIdSchema>;
+export const PolicyId = PolicyIdSchema as unknown as PolicyId;
+
+// -----------------------------
+// Schema for Pairs$cardano/assets/PolicyId_Int
+export const Pairs_PolicyId__Int_Schema = Data.Map(PolicyIdSchema, IntSchema);
+
+export type Pairs_PolicyId__Int_ = Data.Static<typeof Pairs_PolicyId__Int_Schema>;
+export const Pairs_PolicyId__Int_ = Pairs_PolicyId__Int_Schema as unknown as Pairs_PolicyId__Int_;
+
+// -----------------------------
+// Schema for String
+export const PlutusStringSchema = Data.Bytes();
+
+export type PlutusString = Data.Static<typeof PlutusStringSchema>;
+export const PlutusString = PlutusStringSchema as unknown as PlutusString;
+
+// -----------------------------
+// Schema for Tuple$Int_Int
+export const TupleSchema = Data.Tuple([IntSchema, IntSchema]);
+
+export type Tuple = Data.Static<typeof TupleSchema>;
+export const Tuple = TupleSchema as unknown as Tuple;
+
+// -----------------------------
+// Schema for cardano/address/PaymentCredential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const PaymentCredentialSchema = plutusCommon.CredentialSchema;
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type PaymentCredential = Data.Static<typeof PaymentCredentialSchema>;
+export const PaymentCredential = PaymentCredentialSchema as unknown as PaymentCredential;
+
+// -----------------------------
+// Schema for cardano/address/Address
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export const AddressSchema = plutusCommon.AddressSchema;
+
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export type Address = Data.Static<typeof AddressSchema>;
+export const Address = AddressSchema as unknown as Address;
+
+// -----------------------------
+// Schema for market/Action
+/**
+ * What the spender of a listing wants to do
+ *
+ * - `Buy`: Pay the seller and take the item
+ */
+export const ActionSchema = Data.Enum([Data.Literal("Buy"), Data.Literal("Cancel"), Data.Object({ /** Change the asking price - `new_price`: Replaces the listing's price */ Update: Data.Tuple([IntSchema]) })]);
+
+/**
+ * What the spender of a listing wants to do
+ *
+ * - `Buy`: Pay the seller and take the item
+ */
+export type Action = Data.Static<typeof ActionSchema>;
+export const Action = ActionSchema as unknown as Action;
+
+// -----------------------------
+// Schema for market/Listing
+/**
+ * An item for sale.
+ *
+ * The seller is paid `price` lovelace when the listing is bought.
+ */
+export const ListingSchema = Data.Object({ /** Who receives the payment */ seller: AddressSchema, /** Asking price in lovelace */ price: IntSchema, royalty: PairSchema, /** Marketplace fees per policy, paid on top of the price */ fees: Data.Map(ByteArraySchema, IntSchema) });
+
+/**
+ * An item for sale.
+ *
+ * The seller is paid `price` lovelace when the listing is bought.
+ */
+export type Listing = Data.Static<typeof ListingSchema>;
+export const Listing = ListingSchema as unknown as Listing;
+
+// -----------------------------
+// Schema for market/MintAction
+export const MintActionSchema = Data.Enum([Data.Object({ Mint: Data.Tuple([Data.Map(PolicyIdSchema, IntSchema)]) }), Data.Literal("Burn")]);
+
+export type MintAction = Data.Static<typeof MintActionSchema>;
+export const MintAction = MintActionSchema as unknown as MintAction;
+
+// -----------------------------
+// Schema for oracle/FeedRedeemer
+export const FeedRedeemerSchema = Data.Enum([Data.Object({ /** Publish a new price - `window`: Validity window as lower and upper POSIX time */ Publish: Data.Tuple([IntSchema, IntSchema, TupleSchema]) }), Data.Literal("Retire")]);
+
+export type FeedRedeemer = Data.Static<typeof FeedRedeemerSchema>;
+export const FeedRedeemer = FeedRedeemerSchema as unknown as FeedRedeemer;
+
+// -----------------------------
+// Validator market.listing.spend
+/**
+ * Holds items for sale until they are bought or cancelled.
+ *
+ * - Datum `listing`: The listing being spent
+ */
+export const MarketListingSpendValidator = {
+  title: "market.listing.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "581f01010025333573466e1cd55ce9baa357426ae88d55cf1baa00148000526161" },
+  hash: "99f61e3c23f13561c4b25069c4bd4819cdfd27fa92d57677dce676bc",
+  addresses: { mainnet: "addr1wxvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q3jr0qt", preprod: "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w", preview: "addr_test1wzvlv83uy0cn2cwykfgxn39afqvumlf8l2fd2anhmnn8d0q26hn0w" },
+  datum: ListingSchema,
+  redeemer: ActionSchema,
+} as const;
+
+export const MarketListingSpendDatumSchema = ListingSchema;
+export type MarketListingSpendDatum = Data.Static<typeof MarketListingSpendDatumSchema>;
+/** Encodes a datum of market.listing.spend as CBOR hex. */
+export function encodeMarketListingSpendDatum(value: MarketListingSpendDatum): string {
+  return Data.to(value, MarketListingSpendDatumSchema as unknown as MarketListingSpendDatum);
+}
+/** Decodes a datum of market.listing.spend from CBOR hex. */
+export function decodeMarketListingSpendDatum(cbor: string): MarketListingSpendDatum {
+  return Data.from(cbor, MarketListingSpendDatumSchema as unknown as MarketListingSpendDatum);
+}
+
+export const MarketListingSpendRedeemerSchema = ActionSchema;
+export type MarketListingSpendRedeemer = Data.Static<typeof MarketListingSpendRedeemerSchema>;
+/** Encodes a redeemer of market.listing.spend as CBOR hex. */
+export function encodeMarketListingSpendRedeemer(value: MarketListingSpendRedeemer): string {
+  return Data.to(value, MarketListingSpendRedeemerSchema as unknown as MarketListingSpendRedeemer);
+}
+/** Decodes a redeemer of market.listing.spend from CBOR hex. */
+export function decodeMarketListingSpendRedeemer(cbor: string): MarketListingSpendRedeemer {
+  return Data.from(cbor, MarketListingSpendRedeemerSchema as unknown as MarketListingSpendRedeemer);
+}
+
+// -----------------------------
+// Validator market.listing.mint
+export const MarketListingMintValidator = {
+  title: "market.listing.mint",
+  purpose: "mint",
+  script: { type: "PlutusV3", script: "450101002499" },
+  hash: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  policyId: "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4",
+  addresses: { mainnet: "addr1wyvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqav78a2", preprod: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0", preview: "addr_test1wqvxuvh64q9zdqgrjt76d42eclk5wgdxtnsun4808cwg0dqxy2mj0" },
+  redeemer: MintActionSchema,
+} as const;
+
+export const MarketListingMintRedeemerSchema = MintActionSchema;
+export type MarketListingMintRedeemer = Data.Static<typeof MarketListingMintRedeemerSchema>;
+/** Encodes a redeemer of market.listing.mint as CBOR hex. */
+export function encodeMarketListingMintRedeemer(value: MarketListingMintRedeemer): string {
+  return Data.to(value, MarketListingMintRedeemerSchema as unknown as MarketListingMintRedeemer);
+}
+/** Decodes a redeemer of market.listing.mint from CBOR hex. */
+export function decodeMarketListingMintRedeemer(cbor: string): MarketListingMintRedeemer {
+  return Data.from(cbor, MarketListingMintRedeemerSchema as unknown as MarketListingMintRedeemer);
+}
+
+// -----------------------------
+// Validator oracle.feed.withdraw
+export const OracleFeedWithdrawParamsSchema = Data.Tuple([VerificationKeyHashSchema, PlutusStringSchema, Data.Integer()]);
+export type OracleFeedWithdrawParams = Data.Static<typeof OracleFeedWithdrawParamsSchema>;
+/**
+ * Price oracle whose owner publishes prices by withdrawing.
+ *
+ * - Parameter `owner`: Key allowed to publish prices
+ * - Parameter `feed_name`: Human-readable feed name
+ * - Parameter `decimals`: Number of decimals in published prices
+ */
+export const OracleFeedWithdrawValidator = {
+  title: "oracle.feed.withdraw",
+  purpose: "withdraw",
+  script: { type: "PlutusV3", script: "4701010022224981" },
+  hash: "8584a137ba44ecf36aee085b7da213a1825c288743a0a479851905e8",
+  redeemer: FeedRedeemerSchema,
+  parameters: [VerificationKeyHashSchema, PlutusStringSchema, Data.Integer()],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: OracleFeedWithdrawParams) => ({
+    type: "PlutusV3" as const,
+    script: applyParamsToScript("4701010022224981", params, OracleFeedWithdrawParamsSchema as unknown as OracleFeedWithdrawParams),
+  }),
+} as const;
+
+export const OracleFeedWithdrawRedeemerSchema = FeedRedeemerSchema;
+export type OracleFeedWithdrawRedeemer = Data.Static<typeof OracleFeedWithdrawRedeemerSchema>;
+/** Encodes a redeemer of oracle.feed.withdraw as CBOR hex. */
+export function encodeOracleFeedWithdrawRedeemer(value: OracleFeedWithdrawRedeemer): string {
+  return Data.to(value, OracleFeedWithdrawRedeemerSchema as unknown as OracleFeedWithdrawRedeemer);
+}
+/** Decodes a redeemer of oracle.feed.withdraw from CBOR hex. */
+export function decodeOracleFeedWithdrawRedeemer(cbor: string): OracleFeedWithdrawRedeemer {
+  return Data.from(cbor, OracleFeedWithdrawRedeemerSchema as unknown as OracleFeedWithdrawRedeemer);
+}
+
@@ -0,0 +1,346 @@
+// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.
+// Re-generate this by running the code generator script.
+// Generated by gogenesis devel from blueprint sha256:264cac8dc17652164f9380fe015371a3834ab5d669af2d0947923960c2fdb11b.
+import { Data, applyParamsToScript } from '@lucid-evolution/lucid';
+import * as plutusCommon from './plutus-common';
+
+// -----------------------------
+// Schema for Bool
+export const BoolSchema = Data.Boolean();
+
+export type Bool = Data.Static<typeof BoolSchema>;
+export const Bool = BoolSchema as unknown as Bool;
+
+// -----------------------------
+// Schema for ByteArray
+export const ByteArraySchema = Data.Bytes();
+
+export type ByteArray = Data.Static<typeof ByteArraySchema>;
+export const ByteArray = ByteArraySchema as unknown as ByteArray;
+
+// -----------------------------
+// Schema for Data
+/**
+ * Any Plutus data.
+ */
+export const PlutusDataSchema = Data.Any();
+
+/**
+ * Any Plutus data.
+ */
+export type PlutusData = Data.Static<typeof PlutusDataSchema>;
+export const PlutusData = PlutusDataSchema as unknown as PlutusData;
+
+// -----------------------------
+// Schema for Int
+export const IntSchema = Data.Integer();
+
+export type Int = Data.Static<typeof IntSchema>;
+export const Int = IntSchema as unknown as Int;
+
+// -----------------------------
+// Schema for aiken/crypto/VerificationKeyHash
+export const VerificationKeyHashSchema = Data.Bytes();
+
+export type VerificationKeyHash = Data.Static<typeof VerificationKeyHashSchema>;
+export const VerificationKeyHash = VerificationKeyHashSchema as unknown as VerificationKeyHash;
+
+// -----------------------------
+// Schema for List$aiken/crypto/VerificationKeyHash
+export const List_aiken_crypto_VerificationKeyHashSchema = Data.Array(VerificationKeyHashSchema);
+
+export type List_aiken_crypto_VerificationKeyHash = Data.Static<typeof List_aiken_crypto_VerificationKeyHashSchema>;
+export const List_aiken_crypto_VerificationKeyHash = List_aiken_crypto_VerificationKeyHashSchema as unknown as List_aiken_crypto_VerificationKeyHash;
+
+// -----------------------------
+// Schema for aiken/crypto/ScriptHash
+export const ScriptHashSchema = Data.Bytes();
+
+export type ScriptHash = Data.Static<typeof ScriptHashSchema>;
+export const ScriptHash = ScriptHashSchema as unknown as ScriptHash;
+
+// -----------------------------
+// Schema for Option$aiken/crypto/ScriptHash
+export const OptionSchema = Data.Nullable(ScriptHashSchema);
+
+export type Option = Data.Static<typeof OptionSchema>;
+export const Option = OptionSchema as unknown as Option;
+
+// -----------------------------
+// Schema for cardano/address/Credential
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export const CredentialSchema = plutusCommon.CredentialSchema;
+
+/**
+ * A general structure for representing an on-chain `Credential`.
+ */
+export type Credential = Data.Static<typeof CredentialSchema>;
+export const Credential = CredentialSchema as unknown as Credential;
+
+// -----------------------------
+// Schema for cardano/address/StakeCredential
+export const StakeCredentialSchema = plutusCommon.StakeCredentialSchema;
+
+export type StakeCredential = Data.Static<typeof StakeCredentialSchema>;
+export const StakeCredential = StakeCredentialSchema as unknown as StakeCredential;
+
+// -----------------------------
+// Schema for Option$cardano/address/StakeCredential
+export const Option_cardano_address_StakeCredentialSchema = Data.Nullable(StakeCredentialSchema);
+
+export type Option_cardano_address_StakeCredential = Data.Static<typeof Option_cardano_address_StakeCredentialSchema>;
+export const Option_cardano_address_StakeCredential = Option_cardano_address_StakeCredentialSchema as unknown as Option_cardano_address_StakeCredential;
+
+// -----------------------------
+// Schema for cardano/address/PaymentCredential
+export const PaymentCredentialSchema = plutusCommon.CredentialSchema;
+
+export type PaymentCredential = Data.Static<typeof PaymentCredentialSchema>;
+export const PaymentCredential = PaymentCredentialSchema as unknown as PaymentCredential;
+
+// -----------------------------
+// Schema for cardano/address/Address
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export const AddressSchema = plutusCommon.AddressSchema;
+
+/**
+ * A Cardano `Address` typically holding one or two credential references.
+ */
+export type Address = Data.Static<typeof AddressSchema>;
+export const Address = AddressSchema as unknown as Address;
+
+// -----------------------------
+// Schema for cardano/assets/AssetName
+export const AssetNameSchema = Data.Bytes();
+
+export type AssetName = Data.Static<typeof AssetNameSchema>;
+export const AssetName = AssetNameSchema as unknown as AssetName;
+
+// -----------------------------
+// Schema for cardano/assets/PolicyId
+export const PolicyIdSchema = Data.Bytes();
+
+export type PolicyId = Data.Static<typeof PolicyIdSchema>;
+export const PolicyId = PolicyIdSchema as unknown as PolicyId;
+
+// -----------------------------
+// Schema for aiken/crypto/DataHash
+export const DataHashSchema = Data.Bytes();
+
+export type DataHash = Data.Static<typeof DataHashSchema>;
+export const DataHash = DataHashSchema as unknown as DataHash;
+
+// -----------------------------
+// Schema for cardano/transaction/Datum
+export const DatumSchema = Data.Enum([Data.Literal("NoDatum"), Data.Object({ DatumHash: Data.Tuple([DataHashSchema]) }), Data.Object({ InlineDatum: Data.Tuple([PlutusDataSchema]) })]);
+
+export type Datum = Data.Static<typeof DatumSchema>;
+export const Datum = DatumSchema as unknown as Datum;
+
+// -----------------------------
+// Schema for cardano/transaction/Output
+export const OutputSchema = Data.Object({ address: AddressSchema, value: Data.Map(PolicyIdSchema, Data.Map(AssetNameSchema, IntSchema)), datum: DatumSchema, reference_script: OptionSchema });
+
+export type Output = Data.Static<typeof OutputSchema>;
+export const Output = OutputSchema as unknown as Output;
+
+// -----------------------------
+// Schema for List$cardano/transaction/Output
+export const List_cardano_transaction_OutputSchema = Data.Array(OutputSchema);
+
+export type List_cardano_transaction_Output = Data.Static<typeof List_cardano_transaction_OutputSchema>;
+export const List_cardano_transaction_Output = List_cardano_transaction_OutputSchema as unknown as List_cardano_transaction_Output;
+
+// -----------------------------
+// Schema for cardano/transaction/TransactionId
+export const TransactionIdSchema = Data.Bytes();
+
+export type TransactionId = Data.Static<typeof TransactionIdSchema>;
+export const TransactionId = TransactionIdSchema as unknown as TransactionId;
+
+// -----------------------------
+// Schema for cardano/transaction/OutputReference
+/**
+ * An `OutputReference` is a unique reference to an output on-chain.
+ */
+export const OutputReferenceSchema = plutusCommon.OutputReferenceSchema;
+
+/**
+ * An `OutputReference` is a unique reference to an output on-chain.
+ */
+export type OutputReference = Data.Static<typeof OutputReferenceSchema>;
+export const OutputReference = OutputReferenceSchema as unknown as OutputReference;
+
+// -----------------------------
+// Schema for List$cardano/transaction/OutputReference
+export const List_cardano_transaction_OutputReferenceSchema = Data.Array(OutputReferenceSchema);
+
+export type List_cardano_transaction_OutputReference = Data.Static<typeof List_cardano_transaction_OutputReferenceSchema>;
+export const List_cardano_transaction_OutputReference = List_cardano_transaction_OutputReferenceSchema as unknown as List_cardano_transaction_OutputReference;
+
+// -----------------------------
+// Schema for Option$cardano/transaction/Output
+export const Option_cardano_transaction_OutputSchema = Data.Nullable(OutputSchema);
+
+export type Option_cardano_transaction_Output = Data.Static<typeof Option_cardano_transaction_OutputSchema>;
+export const Option_cardano_transaction_Output = Option_cardano_transaction_OutputSchema as unknown as Option_cardano_transaction_Output;
+
+// -----------------------------
+// Schema for Pairs$cardano/assets/AssetName_Int
+export const Pairs_AssetName__Int_Schema = Data.Map(AssetNameSchema, IntSchema);
+
+export type Pairs_AssetName__Int_ = Data.Static<typeof Pairs_AssetName__Int_Schema>;
+export const Pairs_AssetName__Int_ = Pairs_AssetName__Int_Schema as unknown as Pairs_AssetName__Int_;
+
+// -----------------------------
+// Schema for Pairs$cardano/assets/PolicyId_Pairs$cardano/assets/AssetName_Int
+export const Pairs_PolicyId__Pairs_AssetName__Int_Schema = Data.Map(PolicyIdSchema, Data.Map(AssetNameSchema, IntSchema));
+
+export type Pairs_PolicyId__Pairs_AssetName__Int_ = Data.Static<typeof Pairs_PolicyId__Pairs_AssetName__Int_Schema>;
+export const Pairs_PolicyId__Pairs_AssetName__Int_ = Pairs_PolicyId__Pairs_AssetName__Int_Schema as unknown as Pairs_PolicyId__Pairs_AssetName__Int_;
+
+// -----------------------------
+// Schema for aiken/interval/IntervalBoundType
+export const IntervalBoundTypeSchema = Data.Enum([Data.Literal("NegativeInfinity"), Data.Object({ Finite: Data.Tuple([IntSchema]) }), Data.Literal("PositiveInfinity")]);
+
+export type IntervalBoundType = Data.Static<typeof IntervalBoundTypeSchema>;
+export const IntervalBoundType = IntervalBoundTypeSchema as unknown as IntervalBoundType;
+
+// -----------------------------
+// Schema for aiken/interval/IntervalBound
+export const IntervalBoundSchema = Data.Object({ bound_type: IntervalBoundTypeSchema, is_inclusive: BoolSchema });
+
+export type IntervalBound = Data.Static<typeof IntervalBoundSchema>;
+export const IntervalBound = IntervalBoundSchema as unknown as IntervalBound;
+
+// -----------------------------
+// Schema for aiken/interval/Interval
+export const IntervalSchema = Data.Object({ lower_bound: IntervalBoundSchema, upper_bound: IntervalBoundSchema });
+
+export type Interval = Data.Static<typeof IntervalSchema>;
+export const Interval = IntervalSchema as unknown as Interval;
+
+// -----------------------------
+// Schema for treasury/Config
+export const ConfigSchema = Data.Object({ council: Data.Array(VerificationKeyHashSchema), quorum: IntSchema, term: IntervalSchema });
+
+export type Config = Data.Static<typeof ConfigSchema>;
+export const Config = ConfigSchema as unknown as Config;
+
+// -----------------------------
+// Schema for treasury/Action
+export const ActionSchema = Data.Enum([Data.Object({ Disburse: Data.Tuple([Data.Array(OutputSchema)]) }), Data.Object({ Reconfigure: Data.Tuple([ConfigSchema]) }), Data.Literal("Sweep")]);
+
+export type Action = Data.Static<typeof ActionSchema>;
+export const Action = ActionSchema as unknown as Action;
+
+// -----------------------------
+// Schema for treasury/Datum
+export const Treasury_DatumSchema = Data.Object({ config: ConfigSchema, disbursed: Data.Array(OutputReferenceSchema), pending: Option_cardano_transaction_OutputSchema });
+
+export type Treasury_Datum = Data.Static<typeof Treasury_DatumSchema>;
+export const Treasury_Datum = Treasury_DatumSchema as unknown as Treasury_Datum;
+
+// -----------------------------
+// Validator treasury.treasury.spend
+export const TreasuryTreasurySpendParamsSchema = Data.Tuple([PolicyIdSchema, OutputReferenceSchema]);
+export type TreasuryTreasurySpendParams = Data.Static<typeof TreasuryTreasurySpendParamsSchema>;
+export const TreasuryTreasurySpendValidator = {
+  title: "treasury.treasury.spend",
+  purpose: "spend",
+  script: { type: "PlutusV3", script: "4a01010022232499202a01" },
+  hash: "b49b7301a87ba1350d39ba926347ace5c55dd57bdb8dc6d020df3ff1",
+  datum: Treasury_DatumSchema,
+  redeemer: ActionSchema,
+  parameters: [PolicyIdSchema, OutputReferenceSchema],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: TreasuryTreasurySpendParams) => ({
+    type: "PlutusV3" as const,
+    script: applyParamsToScript("4a01010022232499202a01", params, TreasuryTreasurySpendParamsSchema as unknown as TreasuryTreasurySpendParams),
+  }),
+} as const;
+
+export const TreasuryTreasurySpendDatumSchema = Treasury_DatumSchema;
+export type TreasuryTreasurySpendDatum = Data.Static<typeof TreasuryTreasurySpendDatumSchema>;
+/** Encodes a datum of treasury.treasury.spend as CBOR hex. */
+export function encodeTreasuryTreasurySpendDatum(value: TreasuryTreasurySpendDatum): string {
+  return Data.to(value, TreasuryTreasurySpendDatumSchema as unknown as TreasuryTreasurySpendDatum);
+}
+/** Decodes a datum of treasury.treasury.spend from CBOR hex. */
+export function decodeTreasuryTreasurySpendDatum(cbor: string): TreasuryTreasurySpendDatum {
+  return Data.from(cbor, TreasuryTreasurySpendDatumSchema as unknown as TreasuryTreasurySpendDatum);
+}
+
+export const TreasuryTreasurySpendRedeemerSchema = ActionSchema;
+export type TreasuryTreasurySpendRedeemer = Data.Static<typeof TreasuryTreasurySpendRedeemerSchema>;
+/** Encodes a redeemer of treasury.treasury.spend as CBOR hex. */
+export function encodeTreasuryTreasurySpendRedeemer(value: TreasuryTreasurySpendRedeemer): string {
+  return Data.to(value, TreasuryTreasurySpendRedeemerSchema as unknown as TreasuryTreasurySpendRedeemer);
+}
+/** Decodes a redeemer of treasury.treasury.spend from CBOR hex. */
+export function decodeTreasuryTreasurySpendRedeemer(cbor: string): TreasuryTreasurySpendRedeemer {
+  return Data.from(cbor, TreasuryTreasurySpendRedeemerSchema as unknown as TreasuryTreasurySpendRedeemer);
+}
+
+// -----------------------------
+// Validator treasury.treasury.withdraw
+export const TreasuryTreasuryWithdrawParamsSchema = Data.Tuple([PolicyIdSchema, OutputReferenceSchema]);
+export type TreasuryTreasuryWithdrawParams = Data.Static<typeof TreasuryTreasuryWithdrawParamsSchema>;
+export const TreasuryTreasuryWithdrawValidator = {
+  title: "treasury.treasury.withdraw",
+  purpose: "withdraw",
+  script: { type: "PlutusV3", script: "4a01010022232499202a01" },
+  hash: "b49b7301a87ba1350d39ba926347ace5c55dd57bdb8dc6d020df3ff1",
+  redeemer: PlutusDataSchema,
+  parameters: [PolicyIdSchema, OutputReferenceSchema],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: TreasuryTreasuryWithdrawParams) => ({
+    type: "PlutusV3" as const,
+    script: applyParamsToScript("4a01010022232499202a01", params, TreasuryTreasuryWithdrawParamsSchema as unknown as TreasuryTreasuryWithdrawParams),
+  }),
+} as const;
+
+export const TreasuryTreasuryWithdrawRedeemerSchema = PlutusDataSchema;
+export type TreasuryTreasuryWithdrawRedeemer = Data.Static<typeof TreasuryTreasuryWithdrawRedeemerSchema>;
+/** Encodes a redeemer of treasury.treasury.withdraw as CBOR hex. */
+export function encodeTreasuryTreasuryWithdrawRedeemer(value: TreasuryTreasuryWithdrawRedeemer): string {
+  return Data.to(value, TreasuryTreasuryWithdrawRedeemerSchema as unknown as TreasuryTreasuryWithdrawRedeemer);
+}
+/** Decodes a redeemer of treasury.treasury.withdraw from CBOR hex. */
+export function decodeTreasuryTreasuryWithdrawRedeemer(cbor: string): TreasuryTreasuryWithdrawRedeemer {
+  return Data.from(cbor, TreasuryTreasuryWithdrawRedeemerSchema as unknown as TreasuryTreasuryWithdrawRedeemer);
+}
+
+// -----------------------------
+// Validator treasury.treasury.else
+export const TreasuryTreasuryElseParamsSchema = Data.Tuple([PolicyIdSchema, OutputReferenceSchema]);
+export type TreasuryTreasuryElseParams = Data.Static<typeof TreasuryTreasuryElseParamsSchema>;
+export const TreasuryTreasuryElseValidator = {
+  title: "treasury.treasury.else",
+  purpose: "else",
+  script: { type: "PlutusV3", script: "4a01010022232499202a01" },
+  hash: "b49b7301a87ba1350d39ba926347ace5c55dd57bdb8dc6d020df3ff1",
+  redeemer: Data.Any(),
+  parameters: [PolicyIdSchema, OutputReferenceSchema],
+  /** Applies the validator to its parameters, returning the applied script. */
+  applyParams: (params: TreasuryTreasuryElseParams) => ({
+    type: "PlutusV3" as const,
+    script: applyParamsToScript("4a01010022232499202a01", params, TreasuryTreasuryElseParamsSchema as unknown as TreasuryTreasuryElseParams),
+  }),
+} as const;
+
+export const TreasuryTreasuryElseRedeemerSchema = Data.Any();
+export type TreasuryTreasuryElseRedeemer = Data.Static<typeof TreasuryTreasuryElseRedeemerSchema>;
+/** Encodes a redeemer of treasury.treasury.else as CBOR hex. */
+export function encodeTreasuryTreasuryElseRedeemer(value: TreasuryTreasuryElseRedeemer): string {
+  return Data.to(value, TreasuryTreasuryElseRedeemerSchema as unknown as TreasuryTreasuryElseRedeemer);
+}
+/** Decodes a redeemer of treasury.treasury.else from CBOR hex. */
+export function decodeTreasuryTreasuryElseRedeemer(cbor: string): TreasuryTreasuryElseRedeemer {
+  return Data.from(cbor, TreasuryTreasuryElseRedeemerSchema as unknown as TreasuryTreasuryElseRedeemer);
+}
+
@@ -1,10 +1,14 @@
 package typescript
 
 import (
+	_ "embed"
+	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/ir"
 	"github.com/mgpai22/gogenesis/internal/parser"
 )
 
@@ -18,48 +22,202 @@ func (ts *TypeScriptGenerator) FileName() string {
 	return "plutus-types.ts"
 }
 
-// Generate returns the generated TypeScript code as a string.
-// It orders the definitions (via a DFS using memoized dependency collection),
-// delegates schema generation to GenerateTSSchema, and concatenates resulting lines.
-func (ts *TypeScriptGenerator) Generate(schema *parser.PlutusSchema, chosenNames map[string]string) (string, error) {
-	var builder strings.Builder
+// commonFileName is the file backing generator.CommonModule.
+const commonFileName = "plutus-common.ts"
 
-	builder.WriteString("// AUTO-GENERATED FILE. DO NOT EDIT MANUALLY.\n")
-	builder.WriteString("// Re-generate this by running the code generator script.\n")
-	builder.WriteString("import { Data } from '@lucid-evolution/lucid';\n\n")
+// commonHelpers holds the hand-written conversions between the shared well-known types
+// and the Lucid Evolution SDK types; it is appended to the generated common module.
+//
+//go:embed plutus-common.ts.tmpl
+var commonHelpers string
 
-	// Order definitions using DFS with memoized dependency collection.
-	visited := make(map[string]bool)
-	var finalOrder []string
-	depMemo := make(map[string][]string)
+// GenerateFiles returns the generated types and, when any definition is bound to the
+// shared well-known types, the common module they are imported from. With
+// opts.PropertyTests it adds fast-check arbitraries and roundtrip tests of the types.
+func (ts *TypeScriptGenerator) GenerateFiles(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	code, err := ts.Generate(schema, types, chosenNames, opts)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{ts.FileName(): code}
+	for _, module := range generator.TSImports(types, opts.WellKnown) {
+		if module == generator.CommonModule {
+			common, err := generateCommonModule()
+			if err != nil {
+				return nil, err
+			}
+			files[commonFileName] = common
+		}
+	}
+	if opts.PropertyTests {
+		for name, code := range generatePropertyModules(schema, types, chosenNames, opts, "./"+strings.TrimSuffix(ts.FileName(), ".ts")) {
+			files[name] = code
+		}
+	}
+	return files, nil
+}
 
-	var visit func(refName string)
-	visit = func(refName string) {
-		if visited[refName] {
-			return
+// GenerateScriptContext returns a module declaring the script context types of a Plutus
+// version, such as plutus-context-v3.ts. The module stands alone, so its types keep their
+// ledger names.
+func (ts *TypeScriptGenerator) GenerateScriptContext(version string, schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	code, err := ts.Generate(schema, types, chosenNames, opts)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{"plutus-context-" + version + ".ts": code}
+	for _, module := range generator.TSImports(types, opts.WellKnown) {
+		if module == generator.CommonModule {
+			common, err := generateCommonModule()
+			if err != nil {
+				return nil, err
+			}
+			files[commonFileName] = common
 		}
-		visited[refName] = true
-		for _, dep := range generator.CollectDependenciesMemo(refName, schema.Definitions, depMemo) {
-			visit(dep)
+	}
+	return files, nil
+}
+
+// schemaIdentifier matches the schema constants a validator descriptor refers to.
+var schemaIdentifier = regexp.MustCompile(`(?:^|[^\w.$])([A-Za-z_$][\w$]*Schema)\b`)
+
+// schemaDeclaration matches the schema constants declared next to a validator descriptor,
+// such as those of its parameters, which are not imported.
+var schemaDeclaration = regexp.MustCompile(`(?m)^export const ([A-Za-z_$][\w$]*Schema)\b`)
+
+// GenerateProjects returns a module per project exporting the descriptors of the project's
+// validators, which import their schemas from the types generated for shared.
+func (ts *TypeScriptGenerator) GenerateProjects(projects []generator.Project, shared *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (map[string]string, error) {
+	typesModule := "./" + strings.TrimSuffix(ts.FileName(), ".ts")
+	files := make(map[string]string, len(projects))
+	for _, project := range projects {
+		var body strings.Builder
+		for _, v := range project.Schema.Validators {
+			lines := generator.GenerateTSValidator(v, project.Schema.PlutusVersion(), chosenNames, types)
+			for _, line := range lines {
+				body.WriteString(line + "\n")
+			}
+		}
+		used := make(map[string]bool)
+		for _, match := range schemaIdentifier.FindAllStringSubmatch(body.String(), -1) {
+			used[match[1]] = true
+		}
+		for _, match := range schemaDeclaration.FindAllStringSubmatch(body.String(), -1) {
+			delete(used, match[1])
+		}
+		schemas := make([]string, 0, len(used))
+		for name := range used {
+			schemas = append(schemas, name)
+		}
+		sort.Strings(schemas)
+
+		var builder strings.Builder
+		for _, line := range generator.FileHeader(project.Schema) {
+			builder.WriteString("// " + line + "\n")
 		}
-		finalOrder = append(finalOrder, refName)
+		var lucid []string
+		if strings.Contains(body.String(), "Data.") {
+			lucid = append(lucid, "Data")
+		}
+		if strings.Contains(body.String(), "applyParamsToScript(") {
+			lucid = append(lucid, "applyParamsToScript")
+		}
+		if len(lucid) > 0 {
+			builder.WriteString(fmt.Sprintf("import { %s } from '@lucid-evolution/lucid';\n", strings.Join(lucid, ", ")))
+		}
+		if len(schemas) > 0 {
+			builder.WriteString(fmt.Sprintf("import { %s } from '%s';\n", strings.Join(schemas, ", "), typesModule))
+		}
+		builder.WriteString("\n")
+		builder.WriteString(body.String())
+		files[generator.ProjectFileStem(project.Name, "-")+"-validators.ts"] = builder.String()
 	}
+	return files, nil
+}
 
-	// Begin with definitions in alphabetical order.
-	var refNames []string
-	for refName := range schema.Definitions {
-		refNames = append(refNames, refName)
+// generateCommonModule renders the canonical well-known definitions followed by the SDK
+// conversion helpers.
+func generateCommonModule() (string, error) {
+	defs := generator.WellKnownDefinitions()
+	common := &parser.PlutusSchema{Definitions: defs}
+	chosenNames := make(map[string]string, len(defs))
+	for refName, def := range defs {
+		name := def.Title
+		if name == "" {
+			name = refName
+		}
+		chosenNames[refName] = generator.MakeTypeName(name)
 	}
-	sort.Strings(refNames)
-	for _, refName := range refNames {
-		visit(refName)
+	// The common module is generated from its own canonical shapes and must not import itself.
+	opts := generator.GeneratorOptions{
+		WellKnown: generator.DefaultWellKnownRegistry().WithoutModule(generator.CommonModule),
+	}
+	types, err := generator.Resolve(common)
+	if err != nil {
+		return "", fmt.Errorf("invalid common module definitions: %w", err)
+	}
+	code, err := NewTypeScriptGenerator().Generate(common, types, chosenNames, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate the common module: %w", err)
+	}
+	return code + commonHelpers, nil
+}
+
+// Generate returns the generated TypeScript code as a string.
+// It orders the definitions by their dependencies (see generator.DefinitionComponents),
+// delegates schema generation to GenerateTSSchema, or GenerateTSRecursiveSchemas for
+// definitions that refer to each other, and concatenates resulting lines.
+func (ts *TypeScriptGenerator) Generate(schema *parser.PlutusSchema, types *ir.Schema, chosenNames map[string]string, opts generator.GeneratorOptions) (string, error) {
+	var builder strings.Builder
+
+	for _, line := range generator.FileHeader(schema) {
+		builder.WriteString("// " + line + "\n")
+	}
+	if hasParameters(schema.Validators) {
+		builder.WriteString("import { Data, applyParamsToScript } from '@lucid-evolution/lucid';\n")
+	} else {
+		builder.WriteString("import { Data } from '@lucid-evolution/lucid';\n")
+	}
+	imports := generator.TSImports(types, opts.WellKnown)
+	aliases := make([]string, 0, len(imports))
+	for alias := range imports {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		builder.WriteString(fmt.Sprintf("import * as %s from '%s';\n", alias, imports[alias]))
+	}
+	builder.WriteString("\n")
+
+	// Order definitions by their dependencies. Definitions that refer to each other form a
+	// strongly connected component and are generated together.
+	components, deps := generator.DefinitionComponents(types)
+	for _, component := range components {
+		if generator.IsRecursive(component, deps) {
+			for _, line := range generator.TSFillSchemaHelper {
+				builder.WriteString(line + "\n")
+			}
+			break
+		}
 	}
 
 	// Generate a schema for each definition.
-	for _, refName := range finalOrder {
-		def := schema.Definitions[refName]
-		tsTypeName := chosenNames[refName]
-		lines := generator.GenerateTSSchema(refName, def, tsTypeName, chosenNames, schema.Definitions)
+	for _, component := range components {
+		var lines []string
+		if generator.IsRecursive(component, deps) {
+			lines = generator.GenerateTSRecursiveSchemas(component, chosenNames, types, opts, deps)
+		} else {
+			refName := component[0]
+			lines = generator.GenerateTSSchema(refName, chosenNames[refName], chosenNames, types, opts)
+		}
+		for _, line := range lines {
+			builder.WriteString(line + "\n")
+		}
+	}
+
+	// Describe each validator after the schemas it refers to.
+	for _, v := range schema.Validators {
+		lines := generator.GenerateTSValidator(v, schema.PlutusVersion(), chosenNames, types)
 		for _, line := range lines {
 			builder.WriteString(line + "\n")
 		}
@@ -67,3 +225,13 @@ func (ts *TypeScriptGenerator) Generate(schema *parser.PlutusSchema, chosenNames
 
 	return builder.String(), nil
 }
+
+// hasParameters reports whether any of validators takes parameters.
+func hasParameters(validators []parser.PlutusValidator) bool {
+	for _, v := range validators {
+		if len(v.Parameters) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package typescript
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/generator"
+	"github.com/mgpai22/gogenesis/internal/parser"
+	"github.com/mgpai22/gogenesis/internal/version"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestMain pins the version written in file headers, which release builds stamp through
+// -ldflags, to the one in the golden files.
+func TestMain(m *testing.M) {
+	version.Version, version.CommitHash = "", ""
+	os.Exit(m.Run())
+}
+
+func TestGenerateGolden(t *testing.T) {
+	blueprints, err := filepath.Glob("../../../testdata/blueprints/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range blueprints {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		t.Run(name, func(t *testing.T) {
+			schema, err := parser.ParsePlutusJSON(path)
+			if err != nil {
+				t.Fatalf("failed to parse blueprint: %v", err)
+			}
+			out := t.TempDir()
+			opts := generator.GeneratorOptions{
+				Language:  "typescript",
+				WellKnown: generator.DefaultWellKnownRegistry(),
+			}
+			g := generator.NewGeneratorWithOptions(out, opts, NewTypeScriptGenerator())
+			if err := g.Generate(schema); err != nil {
+				t.Fatalf("generation failed: %v", err)
+			}
+			compareGolden(t, out, filepath.Join("testdata", name))
+		})
+	}
+}
+
+// mergedBlueprints are generated together by TestGenerateProjectsGolden.
+var mergedBlueprints = []string{"v3_market", "shared_escrow", "combinators"}
+
+func TestGenerateProjectsGolden(t *testing.T) {
+	var projects []generator.Project
+	for _, name := range mergedBlueprints {
+		schema, err := parser.ParsePlutusJSON(filepath.Join("../../../testdata/blueprints", name+".json"))
+		if err != nil {
+			t.Fatalf("failed to parse blueprint %s: %v", name, err)
+		}
+		projects = append(projects, generator.Project{Name: name, Schema: schema})
+	}
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:  "typescript",
+		WellKnown: generator.DefaultWellKnownRegistry(),
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewTypeScriptGenerator())
+	if err := g.GenerateProjects(projects); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "merged"))
+}
+
+func TestGenerateProjectsConflict(t *testing.T) {
+	intSchema := func(dataType string) *parser.PlutusSchema {
+		return &parser.PlutusSchema{Definitions: map[string]parser.PlutusDefinition{
+			"Int": {DataType: dataType},
+		}}
+	}
+	projects := []generator.Project{
+		{Name: "a", Schema: intSchema("integer")},
+		{Name: "b", Schema: intSchema("integer")},
+		{Name: "c", Schema: intSchema("bytes")},
+	}
+	g := generator.NewGeneratorWithOptions(t.TempDir(), generator.GeneratorOptions{}, NewTypeScriptGenerator())
+	err := g.GenerateProjects(projects)
+	if err == nil || !strings.Contains(err.Error(), "definition Int differs between projects a and c") {
+		t.Errorf("got error %v, want a conflict on Int", err)
+	}
+}
+
+func TestGenerateScriptContextGolden(t *testing.T) {
+	out := t.TempDir()
+	opts := generator.GeneratorOptions{
+		Language:       "typescript",
+		WellKnown:      generator.DefaultWellKnownRegistry(),
+		ScriptContexts: generator.ScriptContextVersions,
+	}
+	g := generator.NewGeneratorWithOptions(out, opts, NewTypeScriptGenerator())
+	if err := g.Generate(&parser.PlutusSchema{}); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	compareGolden(t, out, filepath.Join("testdata", "script_context"))
+}
+
+// propertyTestBlueprints are generated with property tests by
+// TestGeneratePropertyTestsGolden.
+var propertyTestBlueprints = []string{"recursive", "v3_market"}
+
+func TestGeneratePropertyTestsGolden(t *testing.T) {
+	for _, name := range propertyTestBlueprints {
+		t.Run(name, func(t *testing.T) {
+			schema, err := parser.ParsePlutusJSON(filepath.Join("../../../testdata/blueprints", name+".json"))
+			if err != nil {
+				t.Fatalf("failed to parse blueprint: %v", err)
+			}
+			out := t.TempDir()
+			opts := generator.GeneratorOptions{
+				Language:      "typescript",
+				WellKnown:     generator.DefaultWellKnownRegistry(),
+				PropertyTests: true,
+			}
+			g := generator.NewGeneratorWithOptions(out, opts, NewTypeScriptGenerator())
+			if err := g.Generate(schema); err != nil {
+				t.Fatalf("generation failed: %v", err)
+			}
+			compareGolden(t, out, filepath.Join("testdata", "property_tests", name))
+		})
+	}
+}
+
+// compareGolden checks that every file generated into dir matches the file of the same
+// name in goldenDir, rewriting goldenDir instead when -update is set.
+func compareGolden(t *testing.T, dir, goldenDir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, entry := range entries {
+		got, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		goldenPath := filepath.Join(goldenDir, entry.Name())
+		if *update {
+			if err := os.WriteFile(goldenPath, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("missing golden file (run with -update): %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s differs from %s (run with -update to accept)", entry.Name(), goldenPath)
+		}
+	}
+	goldens, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(goldens) != len(entries) {
+		t.Errorf("generated %d files, golden directory holds %d", len(entries), len(goldens))
+	}
+}
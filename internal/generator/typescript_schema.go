@@ -2,99 +2,364 @@ package generator
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 
+	"github.com/mgpai22/gogenesis/internal/ir"
 	"github.com/mgpai22/gogenesis/internal/parser"
 )
 
-// GenerateTSSchema generates TypeScript schema lines for a given definition.
-// It builds a detailed schema expression (e.g. for enums, maps, lists, objects) based on the structure of def.
-func GenerateTSSchema(refName string, def parser.PlutusDefinition, tsTypeName string, chosenNames map[string]string, defs map[string]parser.PlutusDefinition) []string {
+// GenerateTSSchema generates TypeScript schema lines for the definition refName.
+// It builds a detailed schema expression (e.g. for enums, maps, lists, objects) based on the structure of its type.
+// Definitions bound in opts.WellKnown reuse the SDK-provided schema instead.
+// Recursive definitions are generated with GenerateTSRecursiveSchemas instead.
+func GenerateTSSchema(refName string, tsTypeName string, chosenNames map[string]string, types *ir.Schema, opts GeneratorOptions) []string {
+	// Sanitize type name (remove spaces)
+	sanitizedTypeName := strings.ReplaceAll(tsTypeName, " ", "_")
 	lines := []string{
 		"// -----------------------------",
 		fmt.Sprintf("// Schema for %s", refName),
 	}
-	schemaExpr := generateSchemaExpression(def, defs, chosenNames)
-	// Sanitize type name (remove spaces)
-	sanitizedTypeName := strings.ReplaceAll(tsTypeName, " ", "_")
-	if strings.Contains(schemaExpr, sanitizedTypeName+"Schema") {
-		lines = append(lines, fmt.Sprintf("export let %sSchema: any;", sanitizedTypeName))
-		lines = append(lines, fmt.Sprintf("%sSchema = %s;", sanitizedTypeName, schemaExpr))
-	} else {
-		lines = append(lines, fmt.Sprintf("export const %sSchema = %s;", sanitizedTypeName, schemaExpr))
-	}
-	lines = append(lines, "",
-		fmt.Sprintf("export type %s = Data.Static<typeof %sSchema>;", sanitizedTypeName, sanitizedTypeName),
-		fmt.Sprintf("export const %s = %sSchema as unknown as %s;", sanitizedTypeName, sanitizedTypeName, sanitizedTypeName),
+	doc := tsDefinitionDoc(types.Definitions[refName])
+	lines = append(lines, JSDoc(doc)...)
+	lines = append(lines, fmt.Sprintf("export const %sSchema = %s;", sanitizedTypeName, tsSchemaExpression(refName, chosenNames, types, opts)))
+	return append(lines, tsTypeLines(sanitizedTypeName, doc)...)
+}
+
+// TSFillSchemaHelper declares the fillSchema function that GenerateTSRecursiveSchemas
+// relies on. It must be emitted once in every module holding recursive schemas.
+var TSFillSchemaHelper = []string{
+	"// fillSchema completes a schema that was declared before the schemas it depends on.",
+	"// Schemas built from the declaration may hold a shallow copy of it, so its constructor",
+	"// list is updated in place, where those copies share it.",
+	"function fillSchema(target: any, schema: any): void {",
+	"  const anyOf = target.anyOf;",
+	"  Object.assign(target, schema);",
+	"  if (anyOf && schema.anyOf) {",
+	"    anyOf.splice(0, anyOf.length, ...schema.anyOf);",
+	"    target.anyOf = anyOf;",
+	"  }",
+	"}",
+	"",
+}
+
+// GenerateTSRecursiveSchemas generates the schemas of a recursive strongly connected
+// component of the dependency graph (see Components and IsRecursive), whose members
+// refer to each other. Constructor types are declared up front with an empty
+// constructor list and completed with fillSchema once the schemas they use exist.
+// Members without constructors are generated in between, in dependency order, and are
+// only declared up front when they are recursive on their own.
+func GenerateTSRecursiveSchemas(component []string, chosenNames map[string]string, types *ir.Schema, opts GeneratorOptions, deps func(string) []string) []string {
+	schemaName := func(refName string) string {
+		return strings.ReplaceAll(chosenNames[refName], " ", "_") + "Schema"
+	}
+	var constructors, others []string
+	for _, refName := range component {
+		if len(ir.Constructors(types.Definitions[refName].Type)) > 0 {
+			constructors = append(constructors, refName)
+		} else {
+			others = append(others, refName)
+		}
+	}
+
+	lines := []string{
+		"// -----------------------------",
+		fmt.Sprintf("// Forward declarations of the recursive schemas %s", strings.Join(component, ", ")),
+	}
+	for _, refName := range constructors {
+		lines = append(lines, fmt.Sprintf("export const %s: any = { anyOf: [] };", schemaName(refName)))
+	}
+	declared := make(map[string]bool)
+	order := Components(others, deps)
+	for _, sub := range order {
+		if !IsRecursive(sub, deps) {
+			continue
+		}
+		for _, refName := range sub {
+			declared[refName] = true
+			lines = append(lines, fmt.Sprintf("export const %s: any = {};", schemaName(refName)))
+		}
+	}
+	lines = append(lines, "")
+
+	define := func(refName string) {
+		expr := tsSchemaExpression(refName, chosenNames, types, opts)
+		lines = append(lines, "// -----------------------------", fmt.Sprintf("// Schema for %s", refName))
+		if declared[refName] {
+			lines = append(lines, fmt.Sprintf("fillSchema(%s, %s);", schemaName(refName), expr))
+		} else {
+			lines = append(lines, fmt.Sprintf("export const %s = %s;", schemaName(refName), expr))
+		}
+		lines = append(lines, tsTypeLines(strings.TrimSuffix(schemaName(refName), "Schema"), tsDefinitionDoc(types.Definitions[refName]))...)
+	}
+	for _, sub := range order {
+		for _, refName := range sub {
+			define(refName)
+		}
+	}
+	for _, refName := range constructors {
+		declared[refName] = true
+		define(refName)
+	}
+	return lines
+}
+
+// tsSchemaExpression returns the schema expression of a definition.
+func tsSchemaExpression(refName string, chosenNames map[string]string, types *ir.Schema, opts GeneratorOptions) string {
+	if expr, ok := generateWellKnownExpression(refName, chosenNames, types, opts.WellKnown); ok {
+		return expr
+	}
+	def := types.Definitions[refName]
+	if IsWrappedRedeemer(refName, def.Type, opts) {
+		return generateWrappedRedeemerExpression(def.Type, chosenNames, types)
+	}
+	return generateSchemaExpression(def.Type, def.Title, chosenNames, types)
+}
+
+// tsDefinitionDoc returns the documentation of a definition. Constructors without fields
+// become string literals, which cannot carry comments, so the documentation of an enum
+// lists theirs.
+func tsDefinitionDoc(def *ir.Definition) string {
+	doc := strings.TrimSpace(def.Description)
+	sum, ok := def.Type.(*ir.Sum)
+	if !ok {
+		return doc
+	}
+	var items []string
+	for _, cons := range sum.Constructors {
+		if len(cons.Fields) == 0 && cons.Title != "" && cons.Description != "" {
+			items = append(items, "- `"+cons.Title+"`: "+cons.Description)
+		}
+	}
+	if len(items) > 0 {
+		if doc != "" {
+			doc += "\n\n"
+		}
+		doc += strings.Join(items, "\n")
+	}
+	return doc
+}
+
+// tsTypeLines returns the type and value exports that accompany the schema of typeName,
+// documented with description.
+func tsTypeLines(typeName, description string) []string {
+	lines := []string{""}
+	lines = append(lines, JSDoc(description)...)
+	return append(lines,
+		fmt.Sprintf("export type %s = Data.Static<typeof %sSchema>;", typeName, typeName),
+		fmt.Sprintf("export const %s = %sSchema as unknown as %s;", typeName, typeName, typeName),
 		"",
 	)
+}
+
+// GenerateTSValidator generates a TypeScript descriptor for a validator: its script, hash
+// and the schemas of its datum, redeemer and parameters. The datum and redeemer also get
+// typed functions converting them to and from CBOR, such as
+// encodeMarketListingSpendDatum and decodeMarketListingSpendDatum.
+func GenerateTSValidator(v parser.PlutusValidator, plutusVersion string, chosenNames map[string]string, types *ir.Schema) []string {
+	lines := []string{
+		"// -----------------------------",
+		fmt.Sprintf("// Validator %s", v.Title),
+	}
+	name := MakeValidatorName(v.Title)
+	paramsName := strings.TrimSuffix(name, "Validator") + "Params"
+	var params []string
+	for _, p := range v.Parameters {
+		params = append(params, generateTypeExpression(types.Convert(p.Schema), chosenNames, types))
+	}
+	if len(params) > 0 {
+		lines = append(lines,
+			fmt.Sprintf("export const %sSchema = Data.Tuple([%s]);", paramsName, strings.Join(params, ", ")),
+			fmt.Sprintf("export type %s = Data.Static<typeof %sSchema>;", paramsName, paramsName),
+		)
+	}
+	lines = append(lines, JSDoc(ValidatorDoc(v))...)
+	lines = append(lines,
+		fmt.Sprintf("export const %s = {", name),
+		fmt.Sprintf("  title: %q,", v.Title),
+	)
+	if purpose := v.Purpose(); purpose != "" {
+		lines = append(lines, fmt.Sprintf("  purpose: %q,", purpose))
+	}
+	lines = append(lines,
+		fmt.Sprintf("  script: { type: %q, script: %q },", "Plutus"+strings.ToUpper(plutusVersion), v.CompiledCode),
+		fmt.Sprintf("  hash: %q,", v.Hash),
+	)
+	if info := BakedScriptInfo(v, plutusVersion); info != nil {
+		if v.Purpose() == "mint" {
+			lines = append(lines, fmt.Sprintf("  policyId: %q,", info.Hash))
+		}
+		addresses := make([]string, len(info.Addresses))
+		for i, a := range info.Addresses {
+			addresses[i] = fmt.Sprintf("%s: %q", a.Network, a.Address)
+		}
+		lines = append(lines, fmt.Sprintf("  addresses: { %s },", strings.Join(addresses, ", ")))
+	}
+	if v.Datum != nil {
+		lines = append(lines, fmt.Sprintf("  datum: %s,", generateTypeExpression(types.Convert(v.Datum.Schema), chosenNames, types)))
+	}
+	if v.Redeemer != nil {
+		lines = append(lines, fmt.Sprintf("  redeemer: %s,", generateTypeExpression(types.Convert(v.Redeemer.Schema), chosenNames, types)))
+	}
+	if len(params) > 0 {
+		lines = append(lines,
+			fmt.Sprintf("  parameters: [%s],", strings.Join(params, ", ")),
+			"  /** Applies the validator to its parameters, returning the applied script. */",
+			fmt.Sprintf("  applyParams: (params: %s) => ({", paramsName),
+			fmt.Sprintf("    type: %q as const,", "Plutus"+strings.ToUpper(plutusVersion)),
+			fmt.Sprintf("    script: applyParamsToScript(%q, params, %sSchema as unknown as %s),", v.CompiledCode, paramsName, paramsName),
+			"  }),",
+		)
+	}
+	lines = append(lines, "} as const;", "")
+	if v.Datum != nil {
+		lines = append(lines, tsArgumentCodec(v.Title, strings.TrimSuffix(name, "Validator")+"Datum", "datum", types.Convert(v.Datum.Schema), chosenNames, types)...)
+	}
+	if v.Redeemer != nil {
+		lines = append(lines, tsArgumentCodec(v.Title, strings.TrimSuffix(name, "Validator")+"Redeemer", "redeemer", types.Convert(v.Redeemer.Schema), chosenNames, types)...)
+	}
 	return lines
 }
 
+// tsArgumentCodec returns the schema and type of a validator argument, named typeName,
+// and the functions encoding it to CBOR hex and decoding it back with that schema.
+func tsArgumentCodec(title, typeName, argument string, t ir.Type, chosenNames map[string]string, types *ir.Schema) []string {
+	return []string{
+		fmt.Sprintf("export const %sSchema = %s;", typeName, generateTypeExpression(t, chosenNames, types)),
+		fmt.Sprintf("export type %s = Data.Static<typeof %sSchema>;", typeName, typeName),
+		fmt.Sprintf("/** Encodes a %s of %s as CBOR hex. */", argument, title),
+		fmt.Sprintf("export function encode%s(value: %s): string {", typeName, typeName),
+		fmt.Sprintf("  return Data.to(value, %sSchema as unknown as %s);", typeName, typeName),
+		"}",
+		fmt.Sprintf("/** Decodes a %s of %s from CBOR hex. */", argument, title),
+		fmt.Sprintf("export function decode%s(cbor: string): %s {", typeName, typeName),
+		fmt.Sprintf("  return Data.from(cbor, %sSchema as unknown as %s);", typeName, typeName),
+		"}",
+		"",
+	}
+}
+
+// TSImports returns the modules, keyed by namespace alias, that the schemas of the
+// definitions of types import because of well-known type bindings.
+func TSImports(types *ir.Schema, registry *WellKnownRegistry) map[string]string {
+	imports := make(map[string]string)
+	for refName := range types.Definitions {
+		t, _, ok := registry.Lookup(refName, types)
+		if !ok || t.TypeScript == nil || t.TypeScript.Module == "" {
+			continue
+		}
+		imports[ModuleAlias(t.TypeScript.Module)] = t.TypeScript.Module
+	}
+	return imports
+}
+
 //
 // --- Schema Expression Generators ---
 //
 
-// generateSchemaExpression converts the given definition into a Data.* expression.
-// It special-cases wrapped redeemers and uses alternate generators for enums, lists, maps, etc.
-func generateSchemaExpression(def parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	// Special case for wrapped redeemer.
-	if def.Description == "A redeemer wrapped in an extra constructor to make multi-validator detection possible on-chain." {
-		if len(def.AnyOf) > 0 && len(def.AnyOf[0].Fields) > 0 {
-			wrappedType := generateRefExpressionForField(def.AnyOf[0].Fields[0], defs, chosenNames)
-			return fmt.Sprintf("Data.Enum([\n  Data.Object({ Dummy: Data.Tuple([]) }),\n  Data.Object({ Wrapped: Data.Tuple([%s]) })\n])", wrappedType)
-		}
+// generateWellKnownExpression returns the SDK-provided schema for a definition bound in
+// the well-known registry, with its type arguments filled in.
+func generateWellKnownExpression(refName string, chosenNames map[string]string, types *ir.Schema, registry *WellKnownRegistry) (string, bool) {
+	t, args, ok := registry.Lookup(refName, types)
+	if !ok || t.TypeScript == nil {
+		return "", false
 	}
-	if len(def.AnyOf) > 0 {
-		if len(def.AnyOf) > 1 {
-			return generateEnumExpression(def.AnyOf, defs, chosenNames)
-		} else if len(def.AnyOf) == 1 {
-			// For single-constructor records, flatten if the constructor title matches the parent's title.
-			return generateSingleConstructor(def.AnyOf[0], def.Title, defs, chosenNames)
-		}
+	argExprs := make([]string, len(args))
+	for i, arg := range args {
+		argExprs[i] = generateRefExpression(&ir.Ref{Key: arg}, chosenNames, types)
 	}
-	switch def.DataType {
-	case "bytes":
-		return "Data.Bytes()"
-	case "integer":
-		return "Data.Integer()"
-	case "map":
-		return generateMapExpression(def, defs, chosenNames)
-	case "list":
-		return generateListExpressionFromDef(def, defs, chosenNames)
+	expr := ExpandBinding(t.TypeScript.Schema, argExprs)
+	if t.TypeScript.Module != "" {
+		expr = ModuleAlias(t.TypeScript.Module) + "." + expr
+	}
+	return expr, true
+}
+
+// generateWrappedRedeemerExpression returns the schema of a multi-validator redeemer wrapper.
+// A placeholder Dummy constructor occupies index 0 so that the wrapped redeemer is encoded
+// with constructor index 1.
+func generateWrappedRedeemerExpression(t ir.Type, chosenNames map[string]string, types *ir.Schema) string {
+	wrappedType := "Data.Any()"
+	if cons := ir.Constructors(t); len(cons) > 0 && len(cons[0].Fields) > 0 {
+		wrappedType = generateTypeExpression(cons[0].Fields[0].Type, chosenNames, types)
+	}
+	return fmt.Sprintf("Data.Enum([\n  Data.Object({ Dummy: Data.Tuple([]) }),\n  Data.Object({ Wrapped: Data.Tuple([%s]) })\n])", wrappedType)
+}
+
+// generateSchemaExpression converts the type of a definition titled title into a Data.*
+// expression. It uses alternate generators for enums, lists, maps, etc.
+func generateSchemaExpression(t ir.Type, title string, chosenNames map[string]string, types *ir.Schema) string {
+	switch t := t.(type) {
+	case *ir.Ref:
+		// A definition that is only a (titled) reference aliases the referenced schema.
+		return generateRefExpression(t, chosenNames, types)
+	case *ir.Sum:
+		return generateEnumExpression(t.Constructors, chosenNames, types)
+	case *ir.Product:
+		// For single-constructor records, flatten if the constructor title matches the parent's title.
+		return generateSingleConstructor(t.Constructor, title, chosenNames, types)
+	case *ir.Primitive:
+		switch t.Kind {
+		case ir.Integer:
+			return "Data.Integer()"
+		case ir.Boolean:
+			return "Data.Boolean()"
+		case ir.Unit:
+			return "Data.Object({}, { hasConstr: true })"
+		default:
+			// Byte strings, and strings: Lucid has no string schema, so strings travel as
+			// hex-encoded UTF-8 bytes.
+			return "Data.Bytes()"
+		}
+	case *ir.List:
+		return generateListExpression(t, chosenNames, types)
+	case *ir.Map:
+		return generateMapExpression(t, chosenNames, types)
+	case *ir.Tuple:
+		return generateTupleExpression(t.Items, chosenNames, types)
+	case *ir.Pair:
+		return generatePairExpression(t, chosenNames, types)
 	default:
 		return "Data.Any()"
 	}
 }
 
-// generateEnumExpression returns a Data.Enum expression given multiple alternative definitions.
-func generateEnumExpression(alts []parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
+// generateTypeExpression returns the schema expression of an inline type, such as a list
+// item or a constructor field.
+func generateTypeExpression(t ir.Type, chosenNames map[string]string, types *ir.Schema) string {
+	title := ""
+	if p, ok := t.(*ir.Product); ok {
+		title = p.Title
+	}
+	return generateSchemaExpression(t, title, chosenNames, types)
+}
+
+// generateEnumExpression returns a Data.Enum expression given multiple constructors.
+func generateEnumExpression(constructors []*ir.Constructor, chosenNames map[string]string, types *ir.Schema) string {
 	parts := []string{}
-	for _, alt := range alts {
-		parts = append(parts, generateConstructorInEnum(alt, defs, chosenNames))
+	for _, cons := range constructors {
+		parts = append(parts, generateConstructorInEnum(cons, chosenNames, types))
 	}
 	return fmt.Sprintf("Data.Enum([%s])", strings.Join(parts, ", "))
 }
 
 // generateSingleConstructor returns a schema expression for a single constructor.
 // If the constructor's title matches the parent's title, its fields are flattened.
-func generateSingleConstructor(cons parser.PlutusDefinition, parentTitle string, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
+func generateSingleConstructor(cons *ir.Constructor, parentTitle string, chosenNames map[string]string, types *ir.Schema) string {
 	if len(cons.Fields) == 0 {
 		return "Data.Object({}, { hasConstr: true })"
 	}
 	if parentTitle != "" && cons.Title == parentTitle {
 		fieldExprs := []string{}
 		for _, f := range cons.Fields {
-			fieldExprs = append(fieldExprs, fmt.Sprintf("%s: %s", f.Title, generateRefExpressionForField(f, defs, chosenNames)))
+			fieldExprs = append(fieldExprs, fmt.Sprintf("%s%s: %s", InlineJSDoc(f.Description), f.Title, generateTypeExpression(f.Type, chosenNames, types)))
 		}
 		return fmt.Sprintf("Data.Object({ %s })", strings.Join(fieldExprs, ", "))
 	}
-	return generateConstructorAsObject(cons, defs, chosenNames)
+	return generateConstructorAsObject(cons, chosenNames, types)
 }
 
 // generateConstructorInEnum returns a constructor wrapped as an object for use in an enum.
-func generateConstructorInEnum(cons parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
+func generateConstructorInEnum(cons *ir.Constructor, chosenNames map[string]string, types *ir.Schema) string {
 	if len(cons.Fields) == 0 {
 		nm := cons.Title
 		if nm == "" {
@@ -102,15 +367,11 @@ func generateConstructorInEnum(cons parser.PlutusDefinition, defs map[string]par
 		}
 		return fmt.Sprintf("Data.Literal(\"%s\")", nm)
 	}
-	tupleItems := []string{}
-	for _, f := range cons.Fields {
-		tupleItems = append(tupleItems, generateRefExpressionForField(f, defs, chosenNames))
-	}
-	return fmt.Sprintf("Data.Object({ %s: Data.Tuple([%s]) })", cons.Title, strings.Join(tupleItems, ", "))
+	return fmt.Sprintf("Data.Object({ %s%s: Data.Tuple([%s]) })", InlineJSDoc(ConstructorDoc(cons)), cons.Title, generateFieldExpressions(cons, chosenNames, types))
 }
 
 // generateConstructorAsObject returns a Data.Object or Data.Tuple expression depending on the fields.
-func generateConstructorAsObject(cons parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
+func generateConstructorAsObject(cons *ir.Constructor, chosenNames map[string]string, types *ir.Schema) string {
 	constructorTitle := cons.Title
 	if constructorTitle == "" {
 		constructorTitle = "Unknown"
@@ -126,43 +387,58 @@ func generateConstructorAsObject(cons parser.PlutusDefinition, defs map[string]p
 		}
 	}
 	if allFieldsHaveTitles {
-		tupleItems := []string{}
-		for _, f := range cons.Fields {
-			tupleItems = append(tupleItems, generateRefExpressionForField(f, defs, chosenNames))
-		}
-		return fmt.Sprintf("Data.Object({ %s: Data.Tuple([%s]) })", constructorTitle, strings.Join(tupleItems, ", "))
+		return fmt.Sprintf("Data.Object({ %s%s: Data.Tuple([%s]) })", InlineJSDoc(ConstructorDoc(cons)), constructorTitle, generateFieldExpressions(cons, chosenNames, types))
 	}
-	tupleItems := []string{}
+	return fmt.Sprintf("Data.Tuple([%s], { hasConstr: true })", generateFieldExpressions(cons, chosenNames, types))
+}
+
+// generateFieldExpressions returns the comma-separated schema expressions of the fields
+// of a constructor.
+func generateFieldExpressions(cons *ir.Constructor, chosenNames map[string]string, types *ir.Schema) string {
+	items := []string{}
 	for _, f := range cons.Fields {
-		tupleItems = append(tupleItems, generateRefExpressionForField(f, defs, chosenNames))
+		items = append(items, generateTypeExpression(f.Type, chosenNames, types))
 	}
-	return fmt.Sprintf("Data.Tuple([%s], { hasConstr: true })", strings.Join(tupleItems, ", "))
+	return strings.Join(items, ", ")
 }
 
 // generateMapExpression builds a Data.Map expression using the key and value schemas.
-func generateMapExpression(def parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	if def.Keys == nil || def.Values == nil {
-		return "Data.Map(Data.Any(), Data.Any())"
-	}
-	keysExpr := generateRefExpressionForDef(*def.Keys, defs, chosenNames)
-	valuesExpr := generateRefExpressionForDef(*def.Values, defs, chosenNames)
+// Lists of pairs are associative lists and become a Data.Map as well.
+func generateMapExpression(m *ir.Map, chosenNames map[string]string, types *ir.Schema) string {
+	keysExpr := generateTypeExpression(m.Keys, chosenNames, types)
+	valuesExpr := generateTypeExpression(m.Values, chosenNames, types)
 	return fmt.Sprintf("Data.Map(%s, %s)", keysExpr, valuesExpr)
 }
 
-// generateListExpressionFromDef builds a Data.Array expression from a list definition.
-func generateListExpressionFromDef(def parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	if def.Items == nil {
-		return "Data.Array(Data.Any())"
+// generatePairExpression builds a two-element Data.Tuple expression for a builtin pair,
+// which is encoded on-chain as a list holding the left and right values.
+func generatePairExpression(p *ir.Pair, chosenNames map[string]string, types *ir.Schema) string {
+	leftExpr := generateTypeExpression(p.Left, chosenNames, types)
+	rightExpr := generateTypeExpression(p.Right, chosenNames, types)
+	return fmt.Sprintf("Data.Tuple([%s, %s])", leftExpr, rightExpr)
+}
+
+// generateTupleExpression builds a Data.Tuple expression for a heterogeneous list;
+// tuples are encoded on-chain as plain lists.
+func generateTupleExpression(items []ir.Type, chosenNames map[string]string, types *ir.Schema) string {
+	itemExprs := []string{}
+	for _, item := range items {
+		itemExprs = append(itemExprs, generateTypeExpression(item, chosenNames, types))
 	}
-	itemExpr := generateRefExpressionForDef(*def.Items, defs, chosenNames)
+	return fmt.Sprintf("Data.Tuple([%s])", strings.Join(itemExprs, ", "))
+}
+
+// generateListExpression builds a Data.Array expression from a list type and its bounds.
+func generateListExpression(l *ir.List, chosenNames map[string]string, types *ir.Schema) string {
+	itemExpr := generateTypeExpression(l.Items, chosenNames, types)
 	opts := []string{}
-	if def.MinItems != 0 {
-		opts = append(opts, fmt.Sprintf("minItems: %d", def.MinItems))
+	if l.MinItems != 0 {
+		opts = append(opts, fmt.Sprintf("minItems: %d", l.MinItems))
 	}
-	if def.MaxItems != 0 {
-		opts = append(opts, fmt.Sprintf("maxItems: %d", def.MaxItems))
+	if l.MaxItems != 0 {
+		opts = append(opts, fmt.Sprintf("maxItems: %d", l.MaxItems))
 	}
-	if def.UniqueItems {
+	if l.UniqueItems {
 		opts = append(opts, "uniqueItems: true")
 	}
 	if len(opts) > 0 {
@@ -171,87 +447,44 @@ func generateListExpressionFromDef(def parser.PlutusDefinition, defs map[string]
 	return fmt.Sprintf("Data.Array(%s)", itemExpr)
 }
 
-// generateListExpression is a simple wrapper calling generateListExpressionFromDef.
-func generateListExpression(def parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	return generateListExpressionFromDef(def, defs, chosenNames)
-}
-
 //
 // --- Reference Expression Generators ---
 //
 
-// generateRefExpressionForDef returns the schema expression for an inline definition.
-// It resolves $ref references and falls back to the definition's own type.
-func generateRefExpressionForDef(def parser.PlutusDefinition, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	if def.Ref != "" {
-		normalized := normalizeRef(def.Ref)
-		if strings.HasPrefix(normalized, "List$") {
-			if expr, ok := resolveListReference(normalized, defs, chosenNames); ok {
-				return expr
-			}
-			tsType := makeTypeName(normalized)
-			return tsType + "Schema"
-		}
-		if tsType, found := chosenNames[normalized]; found {
-			return tsType + "Schema"
+// generateRefExpression returns the schema expression for a reference: the schema
+// declared for the referenced definition, or the inlined schema of a List$ or Pairs$
+// instantiation.
+func generateRefExpression(ref *ir.Ref, chosenNames map[string]string, types *ir.Schema) string {
+	if IsInlinedCollectionRef(ref.Key) {
+		if expr, ok := resolveListReference(ref.Key, chosenNames, types); ok {
+			return expr
 		}
-		tsType := makeTypeName(normalized)
-		return tsType + "Schema"
+		return makeTypeName(ref.Key) + "Schema"
 	}
-	if def.DataType != "" || len(def.AnyOf) > 0 {
-		return generateSchemaExpression(def, defs, chosenNames)
-	}
-	return "Data.Any()"
-}
-
-// generateRefExpressionForField returns the schema expression for a field.
-func generateRefExpressionForField(field parser.PlutusField, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) string {
-	if field.Ref != "" {
-		normalized := normalizeRef(field.Ref)
-		if strings.HasPrefix(normalized, "List$") {
-			if expr, ok := resolveListReference(normalized, defs, chosenNames); ok {
-				return expr
-			}
-			tsType := makeTypeName(normalized)
-			return tsType + "Schema"
-		}
-		if tsType, found := chosenNames[normalized]; found {
-			return tsType + "Schema"
-		}
-		tsType := makeTypeName(normalized)
+	if tsType, found := chosenNames[ref.Key]; found {
 		return tsType + "Schema"
 	}
-	if field.Items != nil {
-		return generateListExpression(*field.Items, defs, chosenNames)
-	}
-	return "Data.Any()"
+	return makeTypeName(ref.Key) + "Schema"
 }
 
 //
 // --- Helper Functions ---
 //
 
-// normalizeRef removes the "#/definitions/" prefix and replaces "~1" with "/".
-func normalizeRef(ref string) string {
-	ref = strings.TrimPrefix(ref, "#/definitions/")
-	return strings.ReplaceAll(ref, "~1", "/")
-}
-
-// resolveListReference handles List$ prefixed references.
-// It returns a Data.Array or Data.Map expression based on the referenced definition.
-func resolveListReference(normalized string, defs map[string]parser.PlutusDefinition, chosenNames map[string]string) (string, bool) {
-	listDef, ok := defs[normalized]
+// resolveListReference handles List$ and Pairs$ prefixed references.
+// It returns a Data.Array, Data.Map or Data.Tuple expression based on the referenced definition.
+func resolveListReference(key string, chosenNames map[string]string, types *ir.Schema) (string, bool) {
+	def, ok := types.Definitions[key]
 	if !ok {
 		return "", false
 	}
-	if listDef.DataType == "map" && listDef.Keys != nil && listDef.Values != nil {
-		keysExpr := generateRefExpressionForDef(*listDef.Keys, defs, chosenNames)
-		valuesExpr := generateRefExpressionForDef(*listDef.Values, defs, chosenNames)
-		return fmt.Sprintf("Data.Map(%s, %s)", keysExpr, valuesExpr), true
-	}
-	if listDef.Items != nil {
-		itemExpr := generateRefExpressionForDef(*listDef.Items, defs, chosenNames)
-		return fmt.Sprintf("Data.Array(%s)", itemExpr), true
+	switch t := def.Type.(type) {
+	case *ir.Tuple:
+		return generateTupleExpression(t.Items, chosenNames, types), true
+	case *ir.Map:
+		return generateMapExpression(t, chosenNames, types), true
+	case *ir.List:
+		return fmt.Sprintf("Data.Array(%s)", generateTypeExpression(t.Items, chosenNames, types)), true
 	}
 	return "", false
 }
@@ -260,8 +493,7 @@ func resolveListReference(normalized string, defs map[string]parser.PlutusDefini
 func makeTypeName(raw string) string {
 	raw = strings.ReplaceAll(raw, " ", "_")
 	raw = strings.ReplaceAll(raw, "$", "_")
-	re := regexp.MustCompile(`[^\w]+`)
-	raw = re.ReplaceAllString(raw, "_")
+	raw = nonWordChars.ReplaceAllString(raw, "_")
 	if strings.Contains(raw, "~1") {
 		parts := strings.Split(raw, "~1")
 		raw = parts[len(parts)-1]
@@ -0,0 +1,393 @@
+package generator
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// CommonModule is the import path of the TypeScript module gogenesis emits next to
+// the generated types when a blueprint uses one of the shared well-known types.
+const CommonModule = "./plutus-common"
+
+// CardanoPackage is the import path of the Go package declaring the shared well-known
+// types.
+const CardanoPackage = "github.com/mgpai22/gogenesis/cardano"
+
+// TSBinding maps a well-known type onto an existing TypeScript schema.
+type TSBinding struct {
+	// Schema is the schema expression to use, e.g. "Data.Boolean()" or "CredentialSchema".
+	// Placeholders {0}, {1}, ... are replaced with the schemas of the type arguments.
+	Schema string `json:"schema"`
+	// Module, when set, is imported as a namespace and Schema is resolved within it.
+	Module string `json:"module,omitempty"`
+}
+
+// GoBinding maps a well-known type onto an existing Go type.
+type GoBinding struct {
+	// Type is the Go type to use, e.g. "bool" or "*{0}".
+	// Placeholders {0}, {1}, ... are replaced with the Go types of the type arguments.
+	Type string `json:"type"`
+	// Import is the package path that must be imported for Type.
+	Import string `json:"import,omitempty"`
+}
+
+// WellKnownType describes how a blueprint definition maps onto types provided by an SDK
+// (or a module shared between generated packages) instead of being regenerated.
+type WellKnownType struct {
+	TypeScript *TSBinding `json:"typescript,omitempty"`
+	Golang     *GoBinding `json:"golang,omitempty"`
+	// Builtin identifies the built-in Bool and Option bindings, whose Go encoding is
+	// generated rather than delegated to the bound type. It is empty for other bindings.
+	Builtin string `json:"-"`
+	// Shared is the key in WellKnownDefinitions of the canonical definition of a shared
+	// binding, whose Go type is declared by CardanoPackage. It is empty for other bindings.
+	Shared string `json:"-"`
+
+	// match, when set, must accept the type of the blueprint definition before the
+	// binding is used. Built-in entries use it so that a differently shaped type with the
+	// same name is still generated from the blueprint.
+	match func(t ir.Type, types *ir.Schema) bool
+}
+
+// Identifiers of the built-in bindings, see WellKnownType.Builtin.
+const (
+	BuiltinBool   = "bool"
+	BuiltinOption = "option"
+)
+
+// WellKnownRegistry holds the well-known type bindings, keyed by definition reference.
+// A key without a "$" also matches every instantiation of a generic type, so "Option"
+// matches "Option$Int" and "Option$cardano/address/StakeCredential".
+type WellKnownRegistry struct {
+	entries map[string]WellKnownType
+}
+
+//go:embed wellknown.json
+var wellKnownJSON []byte
+
+// wellKnownShapes holds the canonical definitions of the shared well-known types, and
+// wellKnownTypes their resolved form.
+var (
+	wellKnownShapes = mustLoadWellKnownShapes()
+	wellKnownTypes  = mustBuildWellKnownTypes()
+)
+
+func mustLoadWellKnownShapes() map[string]parser.PlutusDefinition {
+	var schema parser.PlutusSchema
+	if err := json.Unmarshal(wellKnownJSON, &schema); err != nil {
+		panic(fmt.Sprintf("invalid embedded wellknown.json: %v", err))
+	}
+	return schema.Definitions
+}
+
+func mustBuildWellKnownTypes() *ir.Schema {
+	types, err := ir.Build(wellKnownShapes)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded wellknown.json: %v", err))
+	}
+	return types
+}
+
+// WellKnownDefinitions returns the canonical definitions backing the shared well-known
+// types, keyed the same way as blueprint definitions.
+func WellKnownDefinitions() map[string]parser.PlutusDefinition {
+	defs := make(map[string]parser.PlutusDefinition, len(wellKnownShapes))
+	for k, v := range wellKnownShapes {
+		defs[k] = v
+	}
+	return defs
+}
+
+// sharedWellKnown lists the blueprint references that map onto a canonical definition
+// from wellknown.json, and the name under which both the common module and
+// CardanoPackage export it. Value and POSIXTime are also listed under the names of the
+// Aiken stdlib v1 and of the Plutus ledger.
+var sharedWellKnown = map[string]struct{ canonical, name string }{
+	"cardano/address/Credential":          {"cardano/address/Credential", "Credential"},
+	"cardano/address/PaymentCredential":   {"cardano/address/Credential", "Credential"},
+	"cardano/address/StakeCredential":     {"cardano/address/StakeCredential", "StakeCredential"},
+	"cardano/address/Address":             {"cardano/address/Address", "Address"},
+	"cardano/transaction/OutputReference": {"cardano/transaction/OutputReference", "OutputReference"},
+	"cardano/assets/Value":                {"cardano/assets/Value", "Value"},
+	"aiken/transaction/value/Value":       {"cardano/assets/Value", "Value"},
+	"Value":                               {"cardano/assets/Value", "Value"},
+	"aiken/time/PosixTime":                {"POSIXTime", "POSIXTime"},
+	"PosixTime":                           {"POSIXTime", "POSIXTime"},
+	"POSIXTime":                           {"POSIXTime", "POSIXTime"},
+}
+
+// DefaultWellKnownRegistry returns a registry with the built-in bindings for the Aiken
+// standard library types.
+func DefaultWellKnownRegistry() *WellKnownRegistry {
+	r := &WellKnownRegistry{entries: make(map[string]WellKnownType)}
+	r.entries["Bool"] = WellKnownType{
+		TypeScript: &TSBinding{Schema: "Data.Boolean()"},
+		Golang:     &GoBinding{Type: "bool"},
+		Builtin:    BuiltinBool,
+		match:      isBoolShape,
+	}
+	r.entries["Option"] = WellKnownType{
+		TypeScript: &TSBinding{Schema: "Data.Nullable({0})"},
+		Golang:     &GoBinding{Type: "*{0}"},
+		Builtin:    BuiltinOption,
+		match:      isOptionShape,
+	}
+	for ref, shared := range sharedWellKnown {
+		canonical := shared.canonical
+		r.entries[ref] = WellKnownType{
+			TypeScript: &TSBinding{Schema: shared.name + "Schema", Module: CommonModule},
+			Golang:     &GoBinding{Type: "cardano." + shared.name, Import: CardanoPackage},
+			Shared:     canonical,
+			match: func(t ir.Type, types *ir.Schema) bool {
+				return SameShape(t, types, wellKnownTypes.Definitions[canonical].Type, wellKnownTypes)
+			},
+		}
+	}
+	return r
+}
+
+// LoadWellKnownRegistry returns the default registry extended with the bindings in the
+// JSON file at path. The file maps definition references to bindings; a null binding
+// removes a built-in entry.
+func LoadWellKnownRegistry(path string) (*WellKnownRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read well-known types: %w", err)
+	}
+	var entries map[string]*WellKnownType
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse well-known types: %w", err)
+	}
+	r := DefaultWellKnownRegistry()
+	for ref, entry := range entries {
+		if entry == nil {
+			delete(r.entries, ref)
+			continue
+		}
+		r.Register(ref, *entry)
+	}
+	return r, nil
+}
+
+// Register adds or replaces the binding for ref.
+func (r *WellKnownRegistry) Register(ref string, t WellKnownType) {
+	r.entries[ref] = t
+}
+
+// WithoutModule returns a copy of the registry without the TypeScript bindings that
+// import module. It is used when generating module itself.
+func (r *WellKnownRegistry) WithoutModule(module string) *WellKnownRegistry {
+	local := &WellKnownRegistry{entries: make(map[string]WellKnownType, len(r.entries))}
+	for ref, t := range r.entries {
+		if t.TypeScript != nil && t.TypeScript.Module == module {
+			continue
+		}
+		local.entries[ref] = t
+	}
+	return local
+}
+
+// Lookup returns the binding for the definition identified by refName, along with the
+// references of its type arguments. It reports false when the definition is unknown or
+// its shape does not match the built-in binding.
+func (r *WellKnownRegistry) Lookup(refName string, types *ir.Schema) (WellKnownType, []string, bool) {
+	if r == nil {
+		return WellKnownType{}, nil, false
+	}
+	def, exists := types.Definitions[refName]
+	if !exists {
+		return WellKnownType{}, nil, false
+	}
+	t, ok := r.entries[refName]
+	if !ok {
+		base, _, generic := strings.Cut(refName, "$")
+		if !generic {
+			return WellKnownType{}, nil, false
+		}
+		if t, ok = r.entries[base]; !ok {
+			return WellKnownType{}, nil, false
+		}
+	}
+	if t.match != nil && !t.match(def.Type, types) {
+		return WellKnownType{}, nil, false
+	}
+	return t, typeArguments(def.Type), true
+}
+
+// typeArguments returns the definitions referenced by the fields of a type's
+// constructors, in order of first appearance. For generic types such as Option$Int this
+// yields the instantiated type arguments.
+func typeArguments(t ir.Type) []string {
+	seen := make(map[string]bool)
+	args := []string{}
+	for _, cons := range ir.Constructors(t) {
+		for _, f := range cons.Fields {
+			ref, ok := f.Type.(*ir.Ref)
+			if ok && !seen[ref.Key] {
+				seen[ref.Key] = true
+				args = append(args, ref.Key)
+			}
+		}
+	}
+	return args
+}
+
+// ModuleAlias returns the identifier under which a module is imported as a namespace,
+// e.g. "plutusCommon" for "./plutus-common".
+func ModuleAlias(module string) string {
+	parts := identifierSeparators.Split(module, -1)
+	alias := ""
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if alias == "" {
+			alias = strings.ToLower(part[:1]) + part[1:]
+		} else {
+			alias += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	if alias == "" || (alias[0] >= '0' && alias[0] <= '9') {
+		alias = "m" + alias
+	}
+	return alias
+}
+
+var placeholderRe = regexp.MustCompile(`\{(\d+)\}`)
+
+// ExpandBinding substitutes the {n} placeholders of a binding template with args.
+func ExpandBinding(template string, args []string) string {
+	return placeholderRe.ReplaceAllStringFunc(template, func(m string) string {
+		n, _ := strconv.Atoi(m[1 : len(m)-1])
+		if n < len(args) {
+			return args[n]
+		}
+		return m
+	})
+}
+
+// isBoolShape reports whether t is the prelude Bool: False and True without fields.
+func isBoolShape(t ir.Type, _ *ir.Schema) bool {
+	sum, ok := t.(*ir.Sum)
+	return ok && len(sum.Constructors) == 2 &&
+		sum.Constructors[0].Title == "False" && len(sum.Constructors[0].Fields) == 0 &&
+		sum.Constructors[1].Title == "True" && len(sum.Constructors[1].Fields) == 0
+}
+
+// isOptionShape reports whether t is the prelude Option: Some with one field, then None.
+func isOptionShape(t ir.Type, _ *ir.Schema) bool {
+	sum, ok := t.(*ir.Sum)
+	if !ok || len(sum.Constructors) != 2 {
+		return false
+	}
+	some, none := sum.Constructors[0], sum.Constructors[1]
+	if some.Title != "Some" || len(some.Fields) != 1 || none.Title != "None" || len(none.Fields) != 0 {
+		return false
+	}
+	_, ok = some.Fields[0].Type.(*ir.Ref)
+	return ok
+}
+
+// SameShape reports whether two types, each resolved against its own schema, describe
+// the same on-chain structure with the same constructor and field names. Definition
+// titles and descriptions are ignored.
+func SameShape(a ir.Type, typesA *ir.Schema, b ir.Type, typesB *ir.Schema) bool {
+	return sameShape(a, typesA, b, typesB, make(map[[2]string]bool))
+}
+
+func sameShape(a ir.Type, typesA *ir.Schema, b ir.Type, typesB *ir.Schema, assumed map[[2]string]bool) bool {
+	refA, isRefA := a.(*ir.Ref)
+	refB, isRefB := b.(*ir.Ref)
+	if isRefA || isRefB {
+		if isRefA && isRefB {
+			// Recursive types are compared coinductively: a pair of references already
+			// under comparison is assumed equal.
+			key := [2]string{refA.Key, refB.Key}
+			if assumed[key] {
+				return true
+			}
+			assumed[key] = true
+		}
+		var ok bool
+		if a, ok = derefType(a, typesA); !ok {
+			return false
+		}
+		if b, ok = derefType(b, typesB); !ok {
+			return false
+		}
+		return sameShape(a, typesA, b, typesB, assumed)
+	}
+	switch a := a.(type) {
+	case *ir.Sum:
+		b, ok := b.(*ir.Sum)
+		return ok && sameConstructors(a.Constructors, typesA, b.Constructors, typesB, assumed)
+	case *ir.Product:
+		b, ok := b.(*ir.Product)
+		return ok && sameConstructors([]*ir.Constructor{a.Constructor}, typesA, []*ir.Constructor{b.Constructor}, typesB, assumed)
+	case *ir.List:
+		b, ok := b.(*ir.List)
+		return ok && sameShape(a.Items, typesA, b.Items, typesB, assumed)
+	case *ir.Map:
+		b, ok := b.(*ir.Map)
+		return ok && sameShape(a.Keys, typesA, b.Keys, typesB, assumed) && sameShape(a.Values, typesA, b.Values, typesB, assumed)
+	case *ir.Tuple:
+		b, ok := b.(*ir.Tuple)
+		if !ok || len(a.Items) != len(b.Items) {
+			return false
+		}
+		for i := range a.Items {
+			if !sameShape(a.Items[i], typesA, b.Items[i], typesB, assumed) {
+				return false
+			}
+		}
+		return true
+	case *ir.Pair:
+		b, ok := b.(*ir.Pair)
+		return ok && sameShape(a.Left, typesA, b.Left, typesB, assumed) && sameShape(a.Right, typesA, b.Right, typesB, assumed)
+	case *ir.Primitive:
+		b, ok := b.(*ir.Primitive)
+		return ok && a.Kind == b.Kind
+	case *ir.Opaque:
+		_, ok := b.(*ir.Opaque)
+		return ok
+	}
+	return false
+}
+
+func sameConstructors(a []*ir.Constructor, typesA *ir.Schema, b []*ir.Constructor, typesB *ir.Schema, assumed map[[2]string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Title != b[i].Title || a[i].Index != b[i].Index || len(a[i].Fields) != len(b[i].Fields) {
+			return false
+		}
+		for j, f := range a[i].Fields {
+			if f.Title != b[i].Fields[j].Title || !sameShape(f.Type, typesA, b[i].Fields[j].Type, typesB, assumed) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// derefType follows a reference to the type of the definition it points at.
+func derefType(t ir.Type, types *ir.Schema) (ir.Type, bool) {
+	ref, ok := t.(*ir.Ref)
+	if !ok {
+		return t, true
+	}
+	def, ok := types.Definitions[ref.Key]
+	if !ok {
+		return nil, false
+	}
+	return def.Type, true
+}
@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/ir"
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// wellKnownTestDefinitions holds prelude and stdlib types, and types sharing their names
+// but not their shape.
+const wellKnownTestDefinitions = `{
+	"Int": {"dataType": "integer"},
+	"ByteArray": {"dataType": "bytes"},
+	"Bool": {"title": "Bool", "anyOf": [
+		{"title": "False", "dataType": "constructor", "index": 0, "fields": []},
+		{"title": "True", "dataType": "constructor", "index": 1, "fields": []}
+	]},
+	"Option$Int": {"title": "Option", "anyOf": [
+		{"title": "Some", "dataType": "constructor", "index": 0, "fields": [{"$ref": "#/definitions/Int"}]},
+		{"title": "None", "dataType": "constructor", "index": 1, "fields": []}
+	]},
+	"Option$ByteArray": {"title": "Option", "anyOf": [
+		{"title": "None", "dataType": "constructor", "index": 0, "fields": []},
+		{"title": "Some", "dataType": "constructor", "index": 1, "fields": [{"$ref": "#/definitions/ByteArray"}]}
+	]},
+	"aiken/crypto/VerificationKeyHash": {"title": "VerificationKeyHash", "dataType": "bytes"},
+	"aiken/crypto/ScriptHash": {"title": "ScriptHash", "dataType": "bytes"},
+	"cardano/address/Credential": {"title": "Credential", "anyOf": [
+		{"title": "VerificationKey", "dataType": "constructor", "index": 0, "fields": [{"$ref": "#/definitions/aiken~1crypto~1VerificationKeyHash"}]},
+		{"title": "Script", "dataType": "constructor", "index": 1, "fields": [{"$ref": "#/definitions/aiken~1crypto~1ScriptHash"}]}
+	]},
+	"cardano/address/PaymentCredential": {"title": "PaymentCredential", "anyOf": [
+		{"title": "VerificationKey", "dataType": "constructor", "index": 0, "fields": [{"$ref": "#/definitions/aiken~1crypto~1VerificationKeyHash"}]},
+		{"title": "Script", "dataType": "constructor", "index": 1, "fields": [{"$ref": "#/definitions/aiken~1crypto~1ScriptHash"}]}
+	]},
+	"cardano/address/StakeCredential": {"title": "StakeCredential", "anyOf": [
+		{"title": "Inline", "dataType": "constructor", "index": 0, "fields": [{"$ref": "#/definitions/ByteArray"}]}
+	]},
+	"Pairs$ByteArray_Int": {"dataType": "map", "keys": {"$ref": "#/definitions/ByteArray"}, "values": {"$ref": "#/definitions/Int"}},
+	"Value": {"title": "Value", "dataType": "map", "keys": {"$ref": "#/definitions/ByteArray"}, "values": {"$ref": "#/definitions/Pairs$ByteArray_Int"}},
+	"cardano/assets/Value": {"title": "Value", "dataType": "map", "keys": {"$ref": "#/definitions/ByteArray"}, "values": {"$ref": "#/definitions/Int"}},
+	"POSIXTime": {"title": "POSIXTime", "dataType": "integer"},
+	"aiken/time/PosixTime": {"title": "PosixTime", "dataType": "bytes"},
+	"my/Wrapper$Int_ByteArray": {"title": "Wrapper", "anyOf": [
+		{"title": "Wrapper", "dataType": "constructor", "index": 0, "fields": [
+			{"title": "key", "$ref": "#/definitions/Int"},
+			{"title": "value", "$ref": "#/definitions/ByteArray"},
+			{"title": "other", "$ref": "#/definitions/Int"}
+		]}
+	]}
+}`
+
+// buildTypes resolves the blueprint definitions in defs, written as JSON.
+func buildTypes(t *testing.T, defs string) *ir.Schema {
+	t.Helper()
+	var parsed map[string]parser.PlutusDefinition
+	if err := json.Unmarshal([]byte(defs), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	types, err := ir.Build(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types
+}
+
+func TestWellKnownLookup(t *testing.T) {
+	types := buildTypes(t, wellKnownTestDefinitions)
+	registry := DefaultWellKnownRegistry()
+	registry.Register("my/Wrapper", WellKnownType{Golang: &GoBinding{Type: "map[{0}]{1}"}})
+
+	tests := []struct {
+		name    string
+		ref     string
+		bound   bool
+		builtin string
+		shared  string
+		args    []string
+		// golang and typescript are the bindings expanded with the type arguments.
+		golang, typescript string
+	}{
+		{name: "prelude Bool", ref: "Bool", bound: true, builtin: BuiltinBool, args: []string{}, golang: "bool", typescript: "Data.Boolean()"},
+		{name: "Option instance", ref: "Option$Int", bound: true, builtin: BuiltinOption, args: []string{"Int"}, golang: "*Int", typescript: "Data.Nullable(Int)"},
+		{name: "Option named type with other constructor order", ref: "Option$ByteArray"},
+		{name: "shared Credential", ref: "cardano/address/Credential", bound: true, shared: "cardano/address/Credential", args: []string{"aiken/crypto/VerificationKeyHash", "aiken/crypto/ScriptHash"}, golang: "cardano.Credential", typescript: "CredentialSchema"},
+		{name: "alias of the Credential shape", ref: "cardano/address/PaymentCredential", bound: true, shared: "cardano/address/Credential", args: []string{"aiken/crypto/VerificationKeyHash", "aiken/crypto/ScriptHash"}, golang: "cardano.Credential", typescript: "CredentialSchema"},
+		{name: "StakeCredential named type with another shape", ref: "cardano/address/StakeCredential"},
+		{name: "ledger Value", ref: "Value", bound: true, shared: "cardano/assets/Value", args: []string{}, golang: "cardano.Value", typescript: "ValueSchema"},
+		{name: "Value named type with another shape", ref: "cardano/assets/Value"},
+		{name: "ledger POSIXTime", ref: "POSIXTime", bound: true, shared: "POSIXTime", args: []string{}, golang: "cardano.POSIXTime", typescript: "POSIXTimeSchema"},
+		{name: "PosixTime named type with another shape", ref: "aiken/time/PosixTime"},
+		{name: "registered generic base", ref: "my/Wrapper$Int_ByteArray", bound: true, args: []string{"Int", "ByteArray"}, golang: "map[Int]ByteArray"},
+		{name: "unbound definition", ref: "Int"},
+		{name: "unknown definition", ref: "Bool$Missing"},
+	}
+	for _, tt := range tests {
+		got, args, ok := registry.Lookup(tt.ref, types)
+		if ok != tt.bound {
+			t.Errorf("%s: bound = %v, want %v", tt.name, ok, tt.bound)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got.Builtin != tt.builtin {
+			t.Errorf("%s: builtin = %q, want %q", tt.name, got.Builtin, tt.builtin)
+		}
+		if got.Shared != tt.shared {
+			t.Errorf("%s: shared = %q, want %q", tt.name, got.Shared, tt.shared)
+		}
+		if !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("%s: args = %q, want %q", tt.name, args, tt.args)
+		}
+		if golang := ""; got.Golang != nil || tt.golang != "" {
+			if got.Golang != nil {
+				golang = ExpandBinding(got.Golang.Type, args)
+			}
+			if golang != tt.golang {
+				t.Errorf("%s: Go binding = %q, want %q", tt.name, golang, tt.golang)
+			}
+		}
+		if typescript := ""; got.TypeScript != nil || tt.typescript != "" {
+			if got.TypeScript != nil {
+				typescript = ExpandBinding(got.TypeScript.Schema, args)
+			}
+			if typescript != tt.typescript {
+				t.Errorf("%s: TypeScript binding = %q, want %q", tt.name, typescript, tt.typescript)
+			}
+		}
+	}
+
+	// Without the common module, the shared types are generated from the blueprint.
+	if _, _, ok := registry.WithoutModule(CommonModule).Lookup("cardano/address/Credential", types); ok {
+		t.Error("Credential is bound without the common module")
+	}
+	var none *WellKnownRegistry
+	if _, _, ok := none.Lookup("Bool", types); ok {
+		t.Error("a nil registry binds Bool")
+	}
+}
+
+func TestExpandBinding(t *testing.T) {
+	tests := []struct {
+		template string
+		args     []string
+		want     string
+	}{
+		{"bool", nil, "bool"},
+		{"*{0}", []string{"big.Int"}, "*big.Int"},
+		{"Pair[{1}, {0}]", []string{"A", "B"}, "Pair[B, A]"},
+		// Placeholders without an argument are left alone.
+		{"Data.Map({0}, {1})", []string{"K"}, "Data.Map(K, {1})"},
+	}
+	for _, tt := range tests {
+		if got := ExpandBinding(tt.template, tt.args); got != tt.want {
+			t.Errorf("ExpandBinding(%q, %q) = %q, want %q", tt.template, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestModuleAlias(t *testing.T) {
+	tests := map[string]string{
+		"./plutus-common":         "plutusCommon",
+		"@lucid-evolution/lucid":  "lucidEvolutionLucid",
+		"../shared/1st-types.ts":  "shared1stTypesTs",
+		"./":                      "m",
+		"./plutus_common/Address": "plutusCommonAddress",
+	}
+	for module, want := range tests {
+		if got := ModuleAlias(module); got != want {
+			t.Errorf("ModuleAlias(%q) = %q, want %q", module, got, want)
+		}
+	}
+}
+
+func TestSameShape(t *testing.T) {
+	types := buildTypes(t, `{
+		"Int": {"dataType": "integer"},
+		"Number": {"title": "Number", "dataType": "integer"},
+		"List": {"title": "List", "anyOf": [
+			{"title": "Cons", "dataType": "constructor", "index": 0, "fields": [{"title": "head", "$ref": "#/definitions/Int"}, {"title": "tail", "$ref": "#/definitions/List"}]},
+			{"title": "Nil", "dataType": "constructor", "index": 1, "fields": []}
+		]},
+		"Chain": {"title": "Chain", "anyOf": [
+			{"title": "Cons", "dataType": "constructor", "index": 0, "fields": [{"title": "head", "$ref": "#/definitions/Number"}, {"title": "tail", "$ref": "#/definitions/Chain"}]},
+			{"title": "Nil", "dataType": "constructor", "index": 1, "fields": []}
+		]},
+		"Renamed": {"title": "Renamed", "anyOf": [
+			{"title": "Cons", "dataType": "constructor", "index": 0, "fields": [{"title": "first", "$ref": "#/definitions/Int"}, {"title": "tail", "$ref": "#/definitions/Renamed"}]},
+			{"title": "Nil", "dataType": "constructor", "index": 1, "fields": []}
+		]},
+		"Reindexed": {"title": "Reindexed", "anyOf": [
+			{"title": "Cons", "dataType": "constructor", "index": 1, "fields": [{"title": "head", "$ref": "#/definitions/Int"}, {"title": "tail", "$ref": "#/definitions/Reindexed"}]},
+			{"title": "Nil", "dataType": "constructor", "index": 0, "fields": []}
+		]}
+	}`)
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		// Recursive types compare equal through differently named references.
+		{"List", "Chain", true},
+		{"Int", "Number", true},
+		{"List", "Renamed", false},
+		{"List", "Reindexed", false},
+		{"Int", "List", false},
+	}
+	for _, tt := range tests {
+		if got := SameShape(types.Definitions[tt.a].Type, types, types.Definitions[tt.b].Type, types); got != tt.want {
+			t.Errorf("SameShape(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+// Package ir holds the resolved form of blueprint schemas that the code generators
+// consume. Build turns the parsed definitions into types once, after parsing:
+// references become definition keys, constructors get their index, lists of builtin
+// pairs become maps, and the result is validated. A target then only maps each kind of
+// type onto its language, rather than reinterpreting the raw schema.
+package ir
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+// Type is a resolved schema: a *Sum, *Product, *List, *Map, *Tuple, *Pair, *Primitive,
+// *Ref or *Opaque.
+type Type interface {
+	isType()
+}
+
+// Sum is a type with several constructors, such as an Aiken enum.
+type Sum struct {
+	Constructors []*Constructor
+}
+
+// Product is a type with a single constructor, a record.
+type Product struct {
+	// Title is the title of the schema, which records usually share with their
+	// constructor.
+	Title       string
+	Constructor *Constructor
+}
+
+// Constructor is an alternative of a Sum or the constructor of a Product, encoded as
+// Plutus data constructor Index holding its fields in order.
+type Constructor struct {
+	Title       string
+	Description string
+	Index       int
+	Fields      []*Field
+}
+
+// Field is a field of a constructor. Fields may be untitled.
+type Field struct {
+	Title       string
+	Description string
+	Type        Type
+}
+
+// List is a list of items of the same type. MinItems and MaxItems are zero when
+// unbounded.
+type List struct {
+	Items       Type
+	MinItems    int
+	MaxItems    int
+	UniqueItems bool
+}
+
+// Map is a Plutus map, which also stands for lists of builtin pairs (Aiken's Pairs): they
+// are encoded on-chain the same way. MinItems and MaxItems bound its entries, and are
+// zero when unbounded. The keys of a map are distinct; those of a list of pairs only
+// when it has unique items, and UniqueKeys tells which.
+type Map struct {
+	Keys       Type
+	Values     Type
+	MinItems   int
+	MaxItems   int
+	UniqueKeys bool
+}
+
+// Tuple is a fixed-size list of items of different types, encoded as a plain list.
+type Tuple struct {
+	Items []Type
+}
+
+// Pair is the #pair builtin, encoded as a list of its left and right values.
+type Pair struct {
+	Left  Type
+	Right Type
+}
+
+// Kind is the kind of a primitive type.
+type Kind int
+
+const (
+	// Integer is an integer of any size, integer or #integer.
+	Integer Kind = iota
+	// Bytes is a byte string, bytes or #bytes.
+	Bytes
+	// String is the #string builtin, encoded as its UTF-8 bytes.
+	String
+	// Boolean is the #boolean builtin, encoded as constructor 0 (false) or 1 (true).
+	Boolean
+	// Unit is the #unit builtin, encoded as constructor 0.
+	Unit
+)
+
+// Primitive is a type without structure. Builtin is set for the builtin types of
+// CIP-57, such as #integer, which a validator parameter is applied as a constant of
+// rather than as Plutus data; nested in data, they encode like their plain counterparts.
+type Primitive struct {
+	Kind    Kind
+	Builtin bool
+}
+
+// Ref refers to the definition with key Key, such as "cardano/address/Address".
+type Ref struct {
+	Key string
+}
+
+// Opaque is any Plutus data, for schemas that do not describe their values.
+type Opaque struct{}
+
+func (*Sum) isType()       {}
+func (*Product) isType()   {}
+func (*List) isType()      {}
+func (*Map) isType()       {}
+func (*Tuple) isType()     {}
+func (*Pair) isType()      {}
+func (*Primitive) isType() {}
+func (*Ref) isType()       {}
+func (*Opaque) isType()    {}
+
+// Definition is a named definition of a blueprint.
+type Definition struct {
+	Key         string
+	Title       string
+	Description string
+	Type        Type
+}
+
+// Schema holds the resolved definitions of a blueprint, keyed like the blueprint's.
+type Schema struct {
+	Definitions map[string]*Definition
+	defs        map[string]parser.PlutusDefinition
+}
+
+// Build resolves the definitions of a blueprint and validates them: references must
+// point to a definition, the constructors of a type must have distinct non-negative
+// indices, and list bounds must be consistent.
+func Build(defs map[string]parser.PlutusDefinition) (*Schema, error) {
+	s := &Schema{
+		Definitions: make(map[string]*Definition, len(defs)),
+		defs:        defs,
+	}
+	keys := make([]string, 0, len(defs))
+	for key := range defs {
+		keys = append(keys, key)
+	}
+	// Report the first error in a fixed order.
+	sort.Strings(keys)
+	for _, key := range keys {
+		def := defs[key]
+		t := s.Convert(def)
+		if err := s.Validate(t); err != nil {
+			return nil, fmt.Errorf("definition %s: %w", key, err)
+		}
+		s.Definitions[key] = &Definition{Key: key, Title: def.Title, Description: def.Description, Type: t}
+	}
+	return s, nil
+}
+
+// Convert resolves an inline schema, such as that of a validator's datum, whose
+// references point to the definitions of s. It does not validate it.
+func (s *Schema) Convert(def parser.PlutusDefinition) Type {
+	if def.Ref != "" {
+		return &Ref{Key: parser.RefKey(def.Ref)}
+	}
+	switch {
+	case len(def.AnyOf) > 1:
+		sum := &Sum{Constructors: make([]*Constructor, len(def.AnyOf))}
+		for i, cons := range def.AnyOf {
+			sum.Constructors[i] = s.constructor(cons, i)
+		}
+		return sum
+	case len(def.AnyOf) == 1:
+		return &Product{Title: def.Title, Constructor: s.constructor(def.AnyOf[0], 0)}
+	}
+	switch def.DataType {
+	case "constructor":
+		return &Product{Title: def.Title, Constructor: s.constructor(def, 0)}
+	case "integer", "#integer":
+		return &Primitive{Kind: Integer, Builtin: def.IsBuiltin()}
+	case "bytes", "#bytes":
+		return &Primitive{Kind: Bytes, Builtin: def.IsBuiltin()}
+	case "#string":
+		return &Primitive{Kind: String, Builtin: def.IsBuiltin()}
+	case "#boolean":
+		return &Primitive{Kind: Boolean, Builtin: def.IsBuiltin()}
+	case "#unit":
+		return &Primitive{Kind: Unit, Builtin: def.IsBuiltin()}
+	case "list":
+		if def.IsTuple() {
+			return s.tuple(def.TupleItems)
+		}
+		if left, right, ok := s.pairItems(def.Items); ok {
+			return &Map{Keys: s.optional(left), Values: s.optional(right), MinItems: def.MinItems, MaxItems: def.MaxItems, UniqueKeys: def.UniqueItems}
+		}
+		return s.list(def)
+	case "#list":
+		return s.list(def)
+	case "map":
+		return &Map{Keys: s.optional(def.Keys), Values: s.optional(def.Values), MinItems: def.MinItems, MaxItems: def.MaxItems, UniqueKeys: true}
+	case "#pair":
+		return &Pair{Left: s.optional(def.Left), Right: s.optional(def.Right)}
+	default:
+		return &Opaque{}
+	}
+}
+
+// Constructors returns the constructors of a sum or record type, or nil for other types.
+func Constructors(t Type) []*Constructor {
+	switch t := t.(type) {
+	case *Sum:
+		return t.Constructors
+	case *Product:
+		return []*Constructor{t.Constructor}
+	}
+	return nil
+}
+
+func (s *Schema) constructor(cons parser.PlutusDefinition, position int) *Constructor {
+	c := &Constructor{
+		Title:       cons.Title,
+		Description: cons.Description,
+		Index:       position,
+		Fields:      make([]*Field, len(cons.Fields)),
+	}
+	if cons.Index != nil {
+		c.Index = *cons.Index
+	}
+	for i, f := range cons.Fields {
+		c.Fields[i] = &Field{Title: f.Title, Description: f.Description, Type: s.field(f)}
+	}
+	return c
+}
+
+// field resolves the schema of a constructor field. Normalization leaves only $ref
+// fields, but the embedded script context schemas are not normalized.
+func (s *Schema) field(f parser.PlutusField) Type {
+	switch {
+	case f.Ref != "":
+		return &Ref{Key: parser.RefKey(f.Ref)}
+	case f.Items != nil:
+		return s.Convert(parser.PlutusDefinition{DataType: "list", Items: f.Items})
+	case f.TupleItems != nil:
+		return s.tuple(f.TupleItems)
+	default:
+		return &Opaque{}
+	}
+}
+
+func (s *Schema) list(def parser.PlutusDefinition) *List {
+	return &List{Items: s.optional(def.Items), MinItems: def.MinItems, MaxItems: def.MaxItems, UniqueItems: def.UniqueItems}
+}
+
+func (s *Schema) tuple(items []parser.PlutusDefinition) *Tuple {
+	t := &Tuple{Items: make([]Type, len(items))}
+	for i, item := range items {
+		t.Items[i] = s.Convert(item)
+	}
+	return t
+}
+
+// optional resolves a schema that a blueprint may leave out, such as the items of a
+// list, which then holds any data.
+func (s *Schema) optional(def *parser.PlutusDefinition) Type {
+	if def == nil {
+		return &Opaque{}
+	}
+	return s.Convert(*def)
+}
+
+// pairItems returns the left and right schemas of list items that are builtin pairs,
+// given inline or by reference.
+func (s *Schema) pairItems(items *parser.PlutusDefinition) (*parser.PlutusDefinition, *parser.PlutusDefinition, bool) {
+	if items == nil {
+		return nil, nil, false
+	}
+	item := *items
+	if item.Ref != "" {
+		target, ok := s.defs[parser.RefKey(item.Ref)]
+		if !ok {
+			return nil, nil, false
+		}
+		item = target
+	}
+	if item.DataType != "#pair" || item.Left == nil || item.Right == nil {
+		return nil, nil, false
+	}
+	return item.Left, item.Right, true
+}
+
+// Validate checks that the references in t point to definitions of s, that constructors
+// have distinct non-negative indices, and that list bounds are consistent.
+func (s *Schema) Validate(t Type) error {
+	switch t := t.(type) {
+	case *Ref:
+		if _, ok := s.defs[t.Key]; !ok {
+			return fmt.Errorf("reference to unknown definition %s", t.Key)
+		}
+	case *Sum:
+		positions := make(map[int]int, len(t.Constructors))
+		for i, cons := range t.Constructors {
+			if other, ok := positions[cons.Index]; ok {
+				return fmt.Errorf("constructors %s and %s share index %d", constructorName(t.Constructors[other], other), constructorName(cons, i), cons.Index)
+			}
+			positions[cons.Index] = i
+			if err := s.validateConstructor(cons); err != nil {
+				return fmt.Errorf("constructor %s: %w", constructorName(cons, i), err)
+			}
+		}
+	case *Product:
+		return s.validateConstructor(t.Constructor)
+	case *List:
+		if err := validateBounds(t.MinItems, t.MaxItems); err != nil {
+			return err
+		}
+		return s.Validate(t.Items)
+	case *Map:
+		if err := validateBounds(t.MinItems, t.MaxItems); err != nil {
+			return err
+		}
+		if err := s.Validate(t.Keys); err != nil {
+			return err
+		}
+		return s.Validate(t.Values)
+	case *Tuple:
+		for _, item := range t.Items {
+			if err := s.Validate(item); err != nil {
+				return err
+			}
+		}
+	case *Pair:
+		if err := s.Validate(t.Left); err != nil {
+			return err
+		}
+		return s.Validate(t.Right)
+	}
+	return nil
+}
+
+func validateBounds(minItems, maxItems int) error {
+	if minItems < 0 || maxItems < 0 {
+		return fmt.Errorf("negative list bounds %d..%d", minItems, maxItems)
+	}
+	if maxItems != 0 && minItems > maxItems {
+		return fmt.Errorf("minItems %d exceeds maxItems %d", minItems, maxItems)
+	}
+	return nil
+}
+
+func (s *Schema) validateConstructor(cons *Constructor) error {
+	if cons.Index < 0 {
+		return fmt.Errorf("negative index %d", cons.Index)
+	}
+	for i, f := range cons.Fields {
+		if err := s.Validate(f.Type); err != nil {
+			if f.Title != "" {
+				return fmt.Errorf("field %s: %w", f.Title, err)
+			}
+			return fmt.Errorf("field %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// constructorName names a constructor in error messages by its title, or by its position
+// if it is untitled.
+func constructorName(cons *Constructor, position int) string {
+	if cons.Title == "" {
+		return fmt.Sprintf("%d", position)
+	}
+	return cons.Title
+}
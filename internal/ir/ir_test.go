@@ -0,0 +1,125 @@
+package ir
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/internal/parser"
+)
+
+func TestBuild(t *testing.T) {
+	schema, err := parser.ParsePlutusJSON("../../testdata/blueprints/v3_treasury.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := Build(schema.Definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types.Definitions) != len(schema.Definitions) {
+		t.Errorf("%d definitions, want %d", len(types.Definitions), len(schema.Definitions))
+	}
+	for key, def := range types.Definitions {
+		if def.Key != key {
+			t.Errorf("definition %s has key %s", key, def.Key)
+		}
+	}
+
+	// Maps have their keys and values resolved to definitions.
+	value := types.Definitions["Pairs$cardano/assets/PolicyId_Pairs$cardano/assets/AssetName_Int"].Type
+	m, ok := value.(*Map)
+	if !ok {
+		t.Fatalf("Pairs = %T, want *Map", value)
+	}
+	if ref, ok := m.Keys.(*Ref); !ok || ref.Key != "cardano/assets/PolicyId" {
+		t.Errorf("map keys = %#v", m.Keys)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	index := 3
+	defs := map[string]parser.PlutusDefinition{
+		"a/Pair":   {DataType: "#pair", Left: &parser.PlutusDefinition{DataType: "integer"}, Right: &parser.PlutusDefinition{DataType: "bytes"}},
+		"a/Pairs":  {DataType: "list", Items: &parser.PlutusDefinition{Ref: "#/definitions/a~1Pair"}, MaxItems: 4},
+		"a/Pairs2": {DataType: "#list", Items: &parser.PlutusDefinition{Ref: "#/definitions/a~1Pair"}},
+		"a/Choice": {Title: "Choice", AnyOf: []parser.PlutusDefinition{
+			{Title: "First"},
+			{Title: "Second", Index: &index, Fields: []parser.PlutusField{{Title: "pair", Ref: "#/definitions/a~1Pair"}}},
+		}},
+		"a/Record": {Title: "Record", AnyOf: []parser.PlutusDefinition{{Title: "Record", Fields: []parser.PlutusField{{Title: "text", Items: &parser.PlutusDefinition{DataType: "#string"}}}}}},
+		"a/Tuple":  {DataType: "list", TupleItems: []parser.PlutusDefinition{{DataType: "#boolean"}, {}}},
+	}
+	types, err := Build(defs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, ok := types.Definitions["a/Pairs"].Type.(*Map); !ok || m.MaxItems != 4 {
+		t.Errorf("list of pairs = %#v, want a *Map of at most 4 entries", types.Definitions["a/Pairs"].Type)
+	}
+	// Builtin lists of pairs are not encoded as maps.
+	if _, ok := types.Definitions["a/Pairs2"].Type.(*List); !ok {
+		t.Errorf("builtin list of pairs = %T, want *List", types.Definitions["a/Pairs2"].Type)
+	}
+	choice := types.Definitions["a/Choice"].Type.(*Sum)
+	if choice.Constructors[0].Index != 0 || choice.Constructors[1].Index != 3 {
+		t.Errorf("constructor indices = %d, %d, want 0, 3", choice.Constructors[0].Index, choice.Constructors[1].Index)
+	}
+	if ref := choice.Constructors[1].Fields[0].Type.(*Ref); ref.Key != "a/Pair" {
+		t.Errorf("field ref = %s, want a/Pair", ref.Key)
+	}
+	record := types.Definitions["a/Record"].Type.(*Product)
+	if list, ok := record.Constructor.Fields[0].Type.(*List); !ok || list.Items.(*Primitive).Kind != String {
+		t.Errorf("inline list field = %#v", record.Constructor.Fields[0].Type)
+	}
+	tuple := types.Definitions["a/Tuple"].Type.(*Tuple)
+	if _, ok := tuple.Items[1].(*Opaque); !ok {
+		t.Errorf("tuple item without schema = %T, want *Opaque", tuple.Items[1])
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	tests := map[string]struct {
+		def  string
+		want string
+	}{
+		"unknown ref": {
+			`{"dataType": "list", "items": {"$ref": "#/definitions/Missing"}}`,
+			"definition X: reference to unknown definition Missing",
+		},
+		"unknown field ref": {
+			`{"anyOf": [{"title": "A", "fields": [{"title": "owner", "$ref": "#/definitions/Missing"}]}]}`,
+			"definition X: field owner: reference to unknown definition Missing",
+		},
+		"shared index": {
+			`{"anyOf": [{"title": "A", "index": 1}, {"title": "B", "index": 1}]}`,
+			"definition X: constructors A and B share index 1",
+		},
+		"implicit shared index": {
+			`{"anyOf": [{"title": "A"}, {"index": 0}]}`,
+			"definition X: constructors A and 1 share index 0",
+		},
+		"negative index": {
+			`{"anyOf": [{"title": "A", "index": -1}]}`,
+			"definition X: negative index -1",
+		},
+		"bounds": {
+			`{"dataType": "list", "items": {"dataType": "integer"}, "minItems": 3, "maxItems": 2}`,
+			"definition X: minItems 3 exceeds maxItems 2",
+		},
+		"map bounds": {
+			`{"dataType": "map", "keys": {"dataType": "bytes"}, "values": {"dataType": "integer"}, "minItems": 3, "maxItems": 2}`,
+			"definition X: minItems 3 exceeds maxItems 2",
+		},
+	}
+	for name, tt := range tests {
+		var def parser.PlutusDefinition
+		if err := json.Unmarshal([]byte(tt.def), &def); err != nil {
+			t.Fatal(err)
+		}
+		_, err := Build(map[string]parser.PlutusDefinition{"X": def})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want %q", name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Normalize lowers the schema combinators that the generators do not handle onto the
+// core model, in place:
+//
+//   - oneOf becomes anyOf: blueprint constructors never overlap, so both mean "one of
+//     these constructors".
+//   - allOf is merged into a single schema. Members may contribute at most one $ref or
+//     one inline structure; titles and descriptions on the outer schema win.
+//   - not is dropped. It only narrows the values a schema admits, which none of the
+//     targets can express, so the remaining schema (or opaque Data) is used.
+//   - Constructor fields that are not a plain $ref, such as inline schemas or refs
+//     wrapped in allOf, are lowered onto a definition of their own that the field
+//     references, so generators only ever see titled $ref fields.
+func Normalize(schema *PlutusSchema) error {
+	n := &normalizer{defs: schema.Definitions}
+	if n.defs == nil {
+		n.defs = make(map[string]PlutusDefinition)
+		schema.Definitions = n.defs
+	}
+	refNames := make([]string, 0, len(n.defs))
+	for refName := range n.defs {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	for _, refName := range refNames {
+		def, err := n.definition(n.defs[refName], refName)
+		if err != nil {
+			return fmt.Errorf("definition %s: %w", refName, err)
+		}
+		n.defs[refName] = def
+	}
+	for i := range schema.Validators {
+		v := &schema.Validators[i]
+		args := []*PlutusArgument{v.Datum, v.Redeemer}
+		for j := range v.Parameters {
+			args = append(args, &v.Parameters[j])
+		}
+		for _, arg := range args {
+			if arg == nil {
+				continue
+			}
+			def, err := n.definition(arg.Schema, MakeDefinitionKey(v.Title, arg.Title))
+			if err != nil {
+				return fmt.Errorf("validator %s, argument %s: %w", v.Title, arg.Title, err)
+			}
+			arg.Schema = def
+		}
+	}
+	return nil
+}
+
+// MakeDefinitionKey returns the key under which a schema nested below parent is lowered
+// onto a definition of its own.
+func MakeDefinitionKey(parent, title string) string {
+	return parent + "$" + title
+}
+
+type normalizer struct {
+	defs map[string]PlutusDefinition
+}
+
+// definition normalizes def, found at path, and everything nested in it.
+func (n *normalizer) definition(def PlutusDefinition, path string) (PlutusDefinition, error) {
+	if len(def.OneOf) > 0 {
+		if len(def.AnyOf) > 0 {
+			return def, fmt.Errorf("both anyOf and oneOf given")
+		}
+		def.AnyOf, def.OneOf = def.OneOf, nil
+	}
+	if len(def.AllOf) > 0 {
+		members := def.AllOf
+		def.AllOf = nil
+		for i, member := range members {
+			member, err := n.definition(member, path)
+			if err != nil {
+				return def, fmt.Errorf("allOf[%d]: %w", i, err)
+			}
+			if err := mergeDefinition(&def, member); err != nil {
+				return def, fmt.Errorf("allOf[%d]: %w", i, err)
+			}
+		}
+	}
+	def.Not = nil
+
+	var err error
+	for i := range def.AnyOf {
+		cons := def.AnyOf[i]
+		consPath := path
+		if cons.Title != "" && len(def.AnyOf) > 1 {
+			consPath = MakeDefinitionKey(path, cons.Title)
+		}
+		if def.AnyOf[i], err = n.definition(cons, consPath); err != nil {
+			return def, fmt.Errorf("anyOf[%d]: %w", i, err)
+		}
+	}
+	for i := range def.Fields {
+		if def.Fields[i], err = n.field(def.Fields[i], path, i); err != nil {
+			return def, fmt.Errorf("field %d: %w", i, err)
+		}
+	}
+	for i := range def.TupleItems {
+		if def.TupleItems[i], err = n.definition(def.TupleItems[i], path); err != nil {
+			return def, fmt.Errorf("items[%d]: %w", i, err)
+		}
+	}
+	for _, child := range []**PlutusDefinition{&def.Items, &def.Keys, &def.Values, &def.Left, &def.Right} {
+		if *child == nil {
+			continue
+		}
+		normalized, err := n.definition(**child, path)
+		if err != nil {
+			return def, err
+		}
+		*child = &normalized
+	}
+	return def, nil
+}
+
+// field normalizes a constructor field, lowering anything but a plain $ref onto a
+// definition of its own.
+func (n *normalizer) field(f PlutusField, parent string, position int) (PlutusField, error) {
+	if f.Schema == nil {
+		return f, nil
+	}
+	title := f.Title
+	if title == "" {
+		title = fmt.Sprintf("%d", position)
+	}
+	schema, err := n.definition(*f.Schema, MakeDefinitionKey(parent, title))
+	if err != nil {
+		return f, err
+	}
+	f.Schema = nil
+	if f.Description == "" {
+		// Descriptions may come from an allOf member.
+		f.Description = schema.Description
+	}
+	if schema.Ref != "" {
+		f.Ref = schema.Ref
+		return f, nil
+	}
+	if !hasStructure(schema) {
+		return f, nil
+	}
+	key := n.uniqueKey(MakeDefinitionKey(parent, title))
+	if schema.Title == "" {
+		schema.Title = f.Title
+	}
+	n.defs[key] = schema
+	f.Ref = MakeDefinitionRef(key)
+	f.Items, f.TupleItems = nil, nil
+	return f, nil
+}
+
+// uniqueKey returns key, or key with a numeric suffix if a definition already uses it.
+func (n *normalizer) uniqueKey(key string) string {
+	unique := key
+	for counter := 1; ; counter++ {
+		if _, taken := n.defs[unique]; !taken {
+			return unique
+		}
+		unique = fmt.Sprintf("%s_%d", key, counter)
+	}
+}
+
+// hasStructure reports whether a schema describes anything beyond opaque Data.
+func hasStructure(def PlutusDefinition) bool {
+	return def.DataType != "" || len(def.AnyOf) > 0 || def.Items != nil || def.TupleItems != nil ||
+		def.Keys != nil || def.Values != nil || def.Left != nil || def.Right != nil
+}
+
+// mergeDefinition merges an allOf member into def.
+func mergeDefinition(def *PlutusDefinition, member PlutusDefinition) error {
+	if def.Title == "" {
+		def.Title = member.Title
+	}
+	if def.Description == "" {
+		def.Description = member.Description
+	}
+	if member.Ref != "" {
+		if def.Ref != "" && def.Ref != member.Ref {
+			return fmt.Errorf("conflicting references %s and %s", def.Ref, member.Ref)
+		}
+		def.Ref = member.Ref
+	}
+	if member.DataType != "" {
+		if def.DataType != "" && def.DataType != member.DataType {
+			return fmt.Errorf("conflicting data types %s and %s", def.DataType, member.DataType)
+		}
+		def.DataType = member.DataType
+	}
+	if len(member.AnyOf) > 0 {
+		if len(def.AnyOf) > 0 {
+			return fmt.Errorf("more than one set of constructors")
+		}
+		def.AnyOf = member.AnyOf
+	}
+	if len(member.Fields) > 0 {
+		if len(def.Fields) > 0 {
+			return fmt.Errorf("more than one set of fields")
+		}
+		def.Fields = member.Fields
+	}
+	for _, pair := range [][2]**PlutusDefinition{
+		{&def.Items, &member.Items},
+		{&def.Keys, &member.Keys},
+		{&def.Values, &member.Values},
+		{&def.Left, &member.Left},
+		{&def.Right, &member.Right},
+	} {
+		if *pair[1] == nil {
+			continue
+		}
+		if *pair[0] != nil {
+			return fmt.Errorf("more than one item, key, value or pair schema")
+		}
+		*pair[0] = *pair[1]
+	}
+	if member.TupleItems != nil {
+		if def.TupleItems != nil {
+			return fmt.Errorf("more than one set of tuple items")
+		}
+		def.TupleItems = member.TupleItems
+	}
+	if member.Index != nil {
+		def.Index = member.Index
+	}
+	// Length constraints combine to the tightest bounds.
+	if member.MinItems > def.MinItems {
+		def.MinItems = member.MinItems
+	}
+	if member.MaxItems != 0 && (def.MaxItems == 0 || member.MaxItems < def.MaxItems) {
+		def.MaxItems = member.MaxItems
+	}
+	def.UniqueItems = def.UniqueItems || member.UniqueItems
+	def.HasConstr = def.HasConstr || member.HasConstr
+	if def.Ref != "" && hasStructure(*def) {
+		return fmt.Errorf("cannot combine $ref %s with an inline schema", def.Ref)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNormalizeCombinators(t *testing.T) {
+	schema, err := ParsePlutusJSON("../../testdata/blueprints/combinators.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	action := schema.Definitions["vault/Action"]
+	if len(action.AnyOf) != 2 || action.OneOf != nil {
+		t.Errorf("oneOf not lowered to anyOf: %+v", action)
+	}
+	if to := action.AnyOf[1].Fields[0]; to.Ref != "#/definitions/Owner" || to.Title != "to" {
+		t.Errorf("allOf field not lowered to a $ref: %+v", to)
+	}
+	if amount := action.AnyOf[0].Fields[0]; amount.Ref != "#/definitions/vault~1Action$Deposit$amount" {
+		t.Errorf("inline field not lowered to a definition: %+v", amount)
+	}
+	if def := schema.Definitions["vault/Action$Deposit$amount"]; def.DataType != "integer" || def.Title != "amount" {
+		t.Errorf("lowered definition = %+v", def)
+	}
+	if memo := schema.Definitions["vault/Vault$memo"]; memo.DataType != "bytes" || memo.Not != nil {
+		t.Errorf("allOf with not not merged: %+v", memo)
+	}
+	if opaque := schema.Definitions["Opaque"]; opaque.Not != nil || opaque.DataType != "" {
+		t.Errorf("not not dropped: %+v", opaque)
+	}
+	redeemer := schema.Validators[0].Redeemer.Schema
+	if redeemer.Ref != "#/definitions/vault~1Action" || redeemer.Description != "What the spender wants to do" {
+		t.Errorf("validator redeemer allOf not merged: %+v", redeemer)
+	}
+}
+
+func TestNormalizeConflicts(t *testing.T) {
+	tests := map[string]string{
+		"anyOf and oneOf":   `{"anyOf": [{"dataType": "constructor"}], "oneOf": [{"dataType": "constructor"}]}`,
+		"two refs":          `{"allOf": [{"$ref": "#/definitions/A"}, {"$ref": "#/definitions/B"}]}`,
+		"two data types":    `{"allOf": [{"dataType": "bytes"}, {"dataType": "integer"}]}`,
+		"ref and structure": `{"allOf": [{"$ref": "#/definitions/A"}, {"dataType": "integer"}]}`,
+	}
+	for name, data := range tests {
+		var def PlutusDefinition
+		if err := json.Unmarshal([]byte(data), &def); err != nil {
+			t.Fatal(err)
+		}
+		schema := &PlutusSchema{Definitions: map[string]PlutusDefinition{"X": def}}
+		if err := Normalize(schema); err == nil {
+			t.Errorf("%s: Normalize succeeded, want an error", name)
+		}
+	}
+}
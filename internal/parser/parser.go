@@ -1,9 +1,12 @@
 package parser
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 )
 
 type PlutusDefinition struct {
@@ -12,34 +15,221 @@ type PlutusDefinition struct {
 	DataType    string             `json:"dataType"`
 	Fields      []PlutusField      `json:"fields"`
 	AnyOf       []PlutusDefinition `json:"anyOf"`
+	OneOf       []PlutusDefinition `json:"oneOf"`
+	AllOf       []PlutusDefinition `json:"allOf"`
+	Not         *PlutusDefinition  `json:"not"`
 	Ref         string             `json:"$ref"`
 	Items       *PlutusDefinition  `json:"items"`
+	TupleItems  []PlutusDefinition `json:"-"`
 	Keys        *PlutusDefinition  `json:"keys"`
 	Values      *PlutusDefinition  `json:"values"`
+	Left        *PlutusDefinition  `json:"left"`
+	Right       *PlutusDefinition  `json:"right"`
 	MinItems    int                `json:"minItems"`
 	MaxItems    int                `json:"maxItems"`
 	UniqueItems bool               `json:"uniqueItems"`
 	HasConstr   bool               `json:"hasConstr"`
+	Index       *int               `json:"index"`
+}
+
+// UnmarshalJSON decodes a definition, accepting both forms of "items" allowed by CIP-57:
+// a single schema for lists, or an array of schemas for tuples, which is stored in
+// TupleItems.
+func (d *PlutusDefinition) UnmarshalJSON(data []byte) error {
+	type plain PlutusDefinition
+	var aux struct {
+		plain
+		Items json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*d = PlutusDefinition(aux.plain)
+	items, tupleItems, err := decodeItems(aux.Items)
+	if err != nil {
+		return err
+	}
+	d.Items, d.TupleItems = items, tupleItems
+	return nil
+}
+
+// IsBuiltin reports whether the definition is one of the builtin primitive types of
+// CIP-57 (#unit, #boolean, #integer, #bytes, #string, #list, #pair). A validator
+// parameter of one of the primitive ones, all but #list and #pair, is applied as a Plutus
+// Core constant of that type. Nested in Data, values of these types are encoded like
+// their plain counterparts, as Aiken does.
+func (d PlutusDefinition) IsBuiltin() bool {
+	return strings.HasPrefix(d.DataType, "#")
+}
+
+// IsTuple reports whether the definition is a fixed-size heterogeneous list.
+func (d PlutusDefinition) IsTuple() bool {
+	return d.DataType == "list" && d.TupleItems != nil
 }
 
 type PlutusField struct {
-	Title string            `json:"title"`
-	Type  string            `json:"type"`
-	Ref   string            `json:"$ref"`
-	Items *PlutusDefinition `json:"items"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Type        string             `json:"type"`
+	Ref         string             `json:"$ref"`
+	Items       *PlutusDefinition  `json:"items"`
+	TupleItems  []PlutusDefinition `json:"-"`
+	// Schema holds the complete field schema. Normalize uses it to lower fields that are
+	// not a plain $ref onto a definition of their own.
+	Schema *PlutusDefinition `json:"-"`
+}
+
+// UnmarshalJSON decodes a field, accepting both forms of "items" like PlutusDefinition.
+func (f *PlutusField) UnmarshalJSON(data []byte) error {
+	type plain PlutusField
+	var aux struct {
+		plain
+		Items json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*f = PlutusField(aux.plain)
+	items, tupleItems, err := decodeItems(aux.Items)
+	if err != nil {
+		return err
+	}
+	f.Items, f.TupleItems = items, tupleItems
+	var schema PlutusDefinition
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return err
+	}
+	f.Schema = &schema
+	return nil
+}
+
+// decodeItems decodes an "items" value holding either one schema or an array of schemas.
+func decodeItems(raw json.RawMessage) (*PlutusDefinition, []PlutusDefinition, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	switch {
+	case trimmed == "" || trimmed == "null":
+		return nil, nil, nil
+	case strings.HasPrefix(trimmed, "["):
+		tupleItems := []PlutusDefinition{}
+		if err := json.Unmarshal(raw, &tupleItems); err != nil {
+			return nil, nil, fmt.Errorf("invalid tuple items: %w", err)
+		}
+		return nil, tupleItems, nil
+	default:
+		var items PlutusDefinition
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, nil, fmt.Errorf("invalid items: %w", err)
+		}
+		return &items, nil, nil
+	}
+}
+
+type PlutusCompiler struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type PlutusPreamble struct {
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Version       string         `json:"version"`
+	PlutusVersion string         `json:"plutusVersion"`
+	Compiler      PlutusCompiler `json:"compiler"`
+	License       string         `json:"license"`
+}
+
+// PlutusArgument is a validator datum, redeemer or parameter.
+type PlutusArgument struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Schema      PlutusDefinition `json:"schema"`
+}
+
+type PlutusValidator struct {
+	Title        string           `json:"title"`
+	Description  string           `json:"description"`
+	Datum        *PlutusArgument  `json:"datum"`
+	Redeemer     *PlutusArgument  `json:"redeemer"`
+	Parameters   []PlutusArgument `json:"parameters"`
+	CompiledCode string           `json:"compiledCode"`
+	Hash         string           `json:"hash"`
 }
 
 type PlutusSchema struct {
+	Preamble    PlutusPreamble              `json:"preamble"`
+	Validators  []PlutusValidator           `json:"validators"`
 	Definitions map[string]PlutusDefinition `json:"definitions"`
+
+	// SourceHashes are the hex-encoded SHA-256 hashes of the blueprint files the schema
+	// was read from: one for a parsed blueprint, one per project for merged schemas.
+	SourceHashes []string `json:"-"`
+}
+
+// Plutus language versions as they appear in a blueprint preamble.
+const (
+	PlutusV1 = "v1"
+	PlutusV2 = "v2"
+	PlutusV3 = "v3"
+)
+
+// PlutusVersion returns the Plutus language version of the blueprint's validators.
+// Blueprints without a version in their preamble are assumed to target Plutus V2.
+func (s *PlutusSchema) PlutusVersion() string {
+	switch v := strings.ToLower(s.Preamble.PlutusVersion); v {
+	case PlutusV1, PlutusV2, PlutusV3:
+		return v
+	default:
+		return PlutusV2
+	}
+}
+
+// validatorPurposes are the purposes of Plutus V3 multi-purpose validators, which Aiken
+// appends to the validator title (e.g. "market.listing.spend").
+var validatorPurposes = map[string]bool{
+	"spend":    true,
+	"mint":     true,
+	"withdraw": true,
+	"publish":  true,
+	"vote":     true,
+	"propose":  true,
+	"else":     true,
+}
+
+// Purpose returns the purpose a V3 validator handler is compiled for, or "" when the
+// title does not name one, as is the case for Plutus V2 blueprints.
+func (v PlutusValidator) Purpose() string {
+	idx := strings.LastIndex(v.Title, ".")
+	if idx < 0 {
+		return ""
+	}
+	if purpose := v.Title[idx+1:]; validatorPurposes[purpose] {
+		return purpose
+	}
+	return ""
+}
+
+// Name returns the validator title without its purpose suffix.
+func (v PlutusValidator) Name() string {
+	if purpose := v.Purpose(); purpose != "" {
+		return strings.TrimSuffix(v.Title, "."+purpose)
+	}
+	return v.Title
 }
 
 func ParsePlutusJSON(filePath string) (*PlutusSchema, error) {
-	file, err := os.Open(filePath)
+	schema, err := readPlutusJSON(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return nil, err
+	}
+	if err := ResolveExternalRefs(schema, filePath); err != nil {
+		return nil, fmt.Errorf("failed to resolve references: %w", err)
 	}
-	defer file.Close()
+	return schema, nil
+}
 
+// readPlutusJSON reads and normalizes a single blueprint file without following
+// references into other files.
+func readPlutusJSON(filePath string) (*PlutusSchema, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
@@ -49,6 +239,14 @@ func ParsePlutusJSON(filePath string) (*PlutusSchema, error) {
 	if err := json.Unmarshal(data, &schema); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
+	sum := sha256.Sum256(data)
+	schema.SourceHashes = []string{hex.EncodeToString(sum[:])}
+	if err := Normalize(&schema); err != nil {
+		return nil, fmt.Errorf("failed to normalize schema: %w", err)
+	}
+	if err := VerifyScripts(&schema); err != nil {
+		return nil, fmt.Errorf("invalid validator scripts: %w", err)
+	}
 
 	return &schema, nil
 }
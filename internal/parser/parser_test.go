@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestValidatorPurpose(t *testing.T) {
+	tests := []struct {
+		title   string
+		purpose string
+		name    string
+	}{
+		{"market.listing.spend", "spend", "market.listing"},
+		{"oracle.feed.withdraw", "withdraw", "oracle.feed"},
+		{"gov.proposals.propose", "propose", "gov.proposals"},
+		{"escrow.escrow", "", "escrow.escrow"},
+		{"always_true", "", "always_true"},
+	}
+	for _, tt := range tests {
+		v := PlutusValidator{Title: tt.title}
+		if got := v.Purpose(); got != tt.purpose {
+			t.Errorf("Purpose(%q) = %q, want %q", tt.title, got, tt.purpose)
+		}
+		if got := v.Name(); got != tt.name {
+			t.Errorf("Name(%q) = %q, want %q", tt.title, got, tt.name)
+		}
+	}
+}
+
+func TestParseV3Blueprint(t *testing.T) {
+	schema, err := ParsePlutusJSON("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := schema.PlutusVersion(); got != PlutusV3 {
+		t.Errorf("PlutusVersion() = %q, want %q", got, PlutusV3)
+	}
+	data, err := os.ReadFile("../../testdata/blueprints/v3_market.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(data)
+	if want := hex.EncodeToString(sum[:]); len(schema.SourceHashes) != 1 || schema.SourceHashes[0] != want {
+		t.Errorf("SourceHashes = %v, want [%s]", schema.SourceHashes, want)
+	}
+	if len(schema.Validators) != 3 {
+		t.Fatalf("got %d validators, want 3", len(schema.Validators))
+	}
+	pair := schema.Definitions["Pair$ByteArray_Int"]
+	if pair.DataType != "#pair" || pair.Left == nil || pair.Right == nil {
+		t.Errorf("Pair$ByteArray_Int not parsed as a builtin pair: %+v", pair)
+	}
+	if v := schema.Validators[2]; len(v.Parameters) != 3 || v.Parameters[0].Title != "owner" || v.Parameters[2].Schema.DataType != "#integer" {
+		t.Errorf("parameters of %s not parsed: %+v", v.Title, v.Parameters)
+	}
+}
+
+func TestUnmarshalTupleItems(t *testing.T) {
+	var def PlutusDefinition
+	data := `{"dataType": "list", "items": [{"$ref": "#/definitions/Int"}, {"dataType": "bytes"}]}`
+	if err := json.Unmarshal([]byte(data), &def); err != nil {
+		t.Fatal(err)
+	}
+	if !def.IsTuple() || len(def.TupleItems) != 2 || def.Items != nil {
+		t.Fatalf("tuple not decoded: %+v", def)
+	}
+	if def.TupleItems[0].Ref != "#/definitions/Int" || def.TupleItems[1].DataType != "bytes" {
+		t.Errorf("unexpected tuple items: %+v", def.TupleItems)
+	}
+
+	def = PlutusDefinition{}
+	data = `{"dataType": "list", "items": {"$ref": "#/definitions/Int"}}`
+	if err := json.Unmarshal([]byte(data), &def); err != nil {
+		t.Fatal(err)
+	}
+	if def.IsTuple() || def.Items == nil || def.Items.Ref != "#/definitions/Int" {
+		t.Errorf("list not decoded: %+v", def)
+	}
+}
@@ -0,0 +1,222 @@
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const definitionsPointer = "#/definitions/"
+
+// ResolveExternalRefs rewrites references into other files, such as
+// "common.json#/definitions/Credential", into references to local definitions.
+// Referenced definitions, and everything they reference in turn, are copied into
+// schema.Definitions under their own key. Paths are relative to the file that contains
+// the reference; filePath is the location of schema itself.
+//
+// Every file is read once. A definition that is already present under the same key must
+// be identical, so types shared between several blueprints are kept once rather than
+// copied. Recursive references, within or across files, resolve to the definition
+// being imported.
+func ResolveExternalRefs(schema *PlutusSchema, filePath string) error {
+	rootPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return err
+	}
+	if schema.Definitions == nil {
+		schema.Definitions = make(map[string]PlutusDefinition)
+	}
+	r := &resolver{
+		rootPath: rootPath,
+		defs:     schema.Definitions,
+		files:    map[string]*PlutusSchema{rootPath: schema},
+		imported: make(map[definitionLocation]string),
+	}
+	refNames := make([]string, 0, len(r.defs))
+	for refName := range r.defs {
+		refNames = append(refNames, refName)
+	}
+	sort.Strings(refNames)
+	for _, refName := range refNames {
+		def := r.defs[refName]
+		if err := r.definition(&def, rootPath); err != nil {
+			return fmt.Errorf("definition %s: %w", refName, err)
+		}
+		r.defs[refName] = def
+	}
+	for i := range schema.Validators {
+		v := &schema.Validators[i]
+		args := []*PlutusArgument{v.Datum, v.Redeemer}
+		for j := range v.Parameters {
+			args = append(args, &v.Parameters[j])
+		}
+		for _, arg := range args {
+			if arg == nil {
+				continue
+			}
+			if err := r.definition(&arg.Schema, rootPath); err != nil {
+				return fmt.Errorf("validator %s, argument %s: %w", v.Title, arg.Title, err)
+			}
+		}
+	}
+	return nil
+}
+
+// definitionLocation identifies a definition by the file that declares it and its key.
+type definitionLocation struct {
+	file string
+	key  string
+}
+
+type resolver struct {
+	rootPath string
+	defs     map[string]PlutusDefinition
+	// files caches every file read so far by absolute path.
+	files map[string]*PlutusSchema
+	// imported maps definitions of other files to their key in defs. Entries are added
+	// before a definition's own references are resolved, which ends recursion.
+	imported map[definitionLocation]string
+}
+
+// definition rewrites, in place, every reference in def, which is declared in file.
+// Definitions read from another file are resolved exactly once, so sharing their nested
+// schemas with the file cache is safe.
+func (r *resolver) definition(def *PlutusDefinition, file string) error {
+	var err error
+	if def.Ref != "" {
+		if def.Ref, err = r.ref(def.Ref, file); err != nil {
+			return err
+		}
+	}
+	for i := range def.AnyOf {
+		if err := r.definition(&def.AnyOf[i], file); err != nil {
+			return err
+		}
+	}
+	for i := range def.Fields {
+		field := &def.Fields[i]
+		if field.Ref != "" {
+			if field.Ref, err = r.ref(field.Ref, file); err != nil {
+				return err
+			}
+		}
+		if field.Items != nil {
+			if err := r.definition(field.Items, file); err != nil {
+				return err
+			}
+		}
+		for j := range field.TupleItems {
+			if err := r.definition(&field.TupleItems[j], file); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range def.TupleItems {
+		if err := r.definition(&def.TupleItems[i], file); err != nil {
+			return err
+		}
+	}
+	for _, child := range []*PlutusDefinition{def.Items, def.Keys, def.Values, def.Left, def.Right} {
+		if child == nil {
+			continue
+		}
+		if err := r.definition(child, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ref resolves a reference found in file and returns the equivalent local reference.
+func (r *resolver) ref(ref, file string) (string, error) {
+	target, key, err := splitRef(ref)
+	if err != nil {
+		return "", err
+	}
+	path := file
+	if target != "" {
+		if strings.Contains(target, "://") {
+			return "", fmt.Errorf("reference %s: only references to local files are supported", ref)
+		}
+		path = filepath.Clean(filepath.Join(filepath.Dir(file), filepath.FromSlash(target)))
+	}
+	if path == r.rootPath {
+		return MakeDefinitionRef(key), nil
+	}
+	localKey, err := r.importDefinition(path, key)
+	if err != nil {
+		return "", fmt.Errorf("reference %s: %w", ref, err)
+	}
+	return MakeDefinitionRef(localKey), nil
+}
+
+// importDefinition copies definition key of file, and everything it references, into
+// the root definitions and returns its key there.
+func (r *resolver) importDefinition(file, key string) (string, error) {
+	location := definitionLocation{file: file, key: key}
+	if localKey, ok := r.imported[location]; ok {
+		return localKey, nil
+	}
+	schema, ok := r.files[file]
+	if !ok {
+		var err error
+		if schema, err = readPlutusJSON(file); err != nil {
+			return "", err
+		}
+		r.files[file] = schema
+	}
+	def, ok := schema.Definitions[key]
+	if !ok {
+		return "", fmt.Errorf("no definition %s in %s", key, file)
+	}
+	r.imported[location] = key
+	if err := r.definition(&def, file); err != nil {
+		return "", err
+	}
+	if existing, ok := r.defs[key]; ok && !reflect.DeepEqual(existing, def) {
+		return "", fmt.Errorf("definition %s in %s differs from the definition already named %s", key, file, key)
+	}
+	r.defs[key] = def
+	return key, nil
+}
+
+// splitRef splits a reference into the file it points into, empty for the current file,
+// and the key of the definition.
+func splitRef(ref string) (file, key string, err error) {
+	file, pointer, found := strings.Cut(ref, "#")
+	if !found || !strings.HasPrefix("#"+pointer, definitionsPointer) {
+		return "", "", fmt.Errorf("reference %s does not point into definitions", ref)
+	}
+	key = strings.TrimPrefix("#"+pointer, definitionsPointer)
+	key = strings.ReplaceAll(key, "~1", "/")
+	key = strings.ReplaceAll(key, "~0", "~")
+	return file, key, nil
+}
+
+// DefinitionKey returns the key of the definition a local reference points to.
+func DefinitionKey(ref string) (string, error) {
+	file, key, err := splitRef(ref)
+	if err == nil && file != "" {
+		err = fmt.Errorf("reference %s points into another file", ref)
+	}
+	return key, err
+}
+
+// RefKey returns the key of the definition a local reference points to, such as
+// "cardano/address/Address" for "#/definitions/cardano~1address~1Address". Parsing leaves
+// only local references, so anything else is returned unchanged.
+func RefKey(ref string) string {
+	key, err := DefinitionKey(ref)
+	if err != nil {
+		return ref
+	}
+	return key
+}
+
+// MakeDefinitionRef returns the local reference to the definition with the given key.
+func MakeDefinitionRef(key string) string {
+	key = strings.ReplaceAll(key, "~", "~0")
+	return definitionsPointer + strings.ReplaceAll(key, "/", "~1")
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveExternalRefs(t *testing.T) {
+	schema, err := ParsePlutusJSON("../../testdata/blueprints/shared_escrow.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"common/Signers", "common/Deadline", "Int", "aiken/crypto/VerificationKeyHash"} {
+		if _, ok := schema.Definitions[key]; !ok {
+			t.Errorf("definition %s not imported", key)
+		}
+	}
+	release := schema.Definitions["escrow/Escrow"].AnyOf[0].Fields[1]
+	if release.Ref != "#/definitions/common~1Signers" {
+		t.Errorf("field reference not rewritten: %s", release.Ref)
+	}
+	if ref := schema.Validators[0].Redeemer.Schema.Ref; ref != "#/definitions/common~1Signers" {
+		t.Errorf("redeemer reference not rewritten: %s", ref)
+	}
+	if ref := schema.Definitions["common/Signers"].AnyOf[1].Fields[0].Ref; ref != "#/definitions/common~1Signers" {
+		t.Errorf("recursive reference not rewritten: %s", ref)
+	}
+}
+
+// writeFiles writes the given files into a temporary directory and returns it.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestResolveCrossFileCycle(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"plutus.json": `{"definitions": {"Root": {"$ref": "types/a.json#/definitions/A"}}}`,
+		"types/a.json": `{"definitions": {"A": {"anyOf": [
+			{"title": "A", "dataType": "constructor", "index": 0, "fields": [{"title": "b", "$ref": "b.json#/definitions/B"}]}
+		]}}}`,
+		"types/b.json": `{"definitions": {"B": {"anyOf": [
+			{"title": "End", "dataType": "constructor", "index": 0, "fields": []},
+			{"title": "Next", "dataType": "constructor", "index": 1, "fields": [{"title": "a", "$ref": "a.json#/definitions/A"}]},
+			{"title": "Back", "dataType": "constructor", "index": 2, "fields": [{"title": "root", "$ref": "../plutus.json#/definitions/Root"}]}
+		]}}}`,
+	})
+	schema, err := ParsePlutusJSON(filepath.Join(dir, "plutus.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := schema.Definitions["B"]
+	if len(b.AnyOf) != 3 {
+		t.Fatalf("definition B not imported: %+v", schema.Definitions)
+	}
+	if ref := b.AnyOf[1].Fields[0].Ref; ref != "#/definitions/A" {
+		t.Errorf("cyclic reference = %s, want #/definitions/A", ref)
+	}
+	if ref := b.AnyOf[2].Fields[0].Ref; ref != "#/definitions/Root" {
+		t.Errorf("reference back into the root = %s, want #/definitions/Root", ref)
+	}
+}
+
+func TestResolveExternalRefErrors(t *testing.T) {
+	tests := map[string]struct {
+		files map[string]string
+		want  string
+	}{
+		"conflicting definition": {
+			files: map[string]string{
+				"plutus.json": `{"definitions": {"Int": {"dataType": "bytes"}, "X": {"$ref": "common.json#/definitions/Int"}}}`,
+				"common.json": `{"definitions": {"Int": {"dataType": "integer"}}}`,
+			},
+			want: "differs",
+		},
+		"missing definition": {
+			files: map[string]string{
+				"plutus.json": `{"definitions": {"X": {"$ref": "common.json#/definitions/Missing"}}}`,
+				"common.json": `{"definitions": {}}`,
+			},
+			want: "no definition Missing",
+		},
+		"missing file": {
+			files: map[string]string{
+				"plutus.json": `{"definitions": {"X": {"$ref": "nowhere.json#/definitions/X"}}}`,
+			},
+			want: "failed to read file",
+		},
+		"remote reference": {
+			files: map[string]string{
+				"plutus.json": `{"definitions": {"X": {"$ref": "https://example.com/common.json#/definitions/X"}}}`,
+			},
+			want: "local files",
+		},
+	}
+	for name, tt := range tests {
+		dir := writeFiles(t, tt.files)
+		_, err := ParsePlutusJSON(filepath.Join(dir, "plutus.json"))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: got error %v, want one containing %q", name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/mgpai22/gogenesis/uplc"
+)
+
+// VerifyScripts checks that the compiled code of each validator is a flat-encoded
+// program wrapped in one or two CBOR byte strings, and that its hash matches the one
+// the blueprint declares. Validators without compiled code are skipped. All failures
+// are reported.
+func VerifyScripts(schema *PlutusSchema) error {
+	var errs []error
+	for _, v := range schema.Validators {
+		if v.CompiledCode == "" {
+			continue
+		}
+		if _, err := uplc.ParseScript(v.CompiledCode, schema.PlutusVersion()); err != nil {
+			errs = append(errs, fmt.Errorf("validator %s: %w", v.Title, err))
+			continue
+		}
+		if v.Hash == "" {
+			continue
+		}
+		hash, err := uplc.CompiledCodeHash(v.CompiledCode, schema.PlutusVersion())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("validator %s: %w", v.Title, err))
+			continue
+		}
+		if got := hex.EncodeToString(hash); got != v.Hash {
+			errs = append(errs, fmt.Errorf("validator %s: hash %s does not match the hash of its compiled code, %s", v.Title, v.Hash, got))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyScripts(t *testing.T) {
+	const hash = "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4"
+	tests := map[string]struct {
+		code, hash string
+		want       string
+	}{
+		"valid":            {"450101002499", hash, ""},
+		"double wrapped":   {"46450101002499", hash, ""},
+		"no hash":          {"450101002499", "", ""},
+		"no compiled code": {"", hash, ""},
+		"hash mismatch":    {"450101002499", "00" + hash[2:], "does not match the hash of its compiled code, " + hash},
+		"not cbor":         {"0101", hash, "compiled code is not a CBOR byte string"},
+		"not flat":         {"450101002f01", hash, "invalid flat program: unknown term tag 15"},
+	}
+	for name, tt := range tests {
+		schema := &PlutusSchema{
+			Preamble:   PlutusPreamble{PlutusVersion: "v3"},
+			Validators: []PlutusValidator{{Title: "v.mint", CompiledCode: tt.code, Hash: tt.hash}},
+		}
+		err := VerifyScripts(schema)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: VerifyScripts: %v", name, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: VerifyScripts error = %v, want %q", name, err, tt.want)
+		}
+	}
+}
+
+func TestParseRejectsHashMismatch(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"plutus.json": `{"preamble": {"plutusVersion": "v3"}, "validators": [
+			{"title": "a.mint", "compiledCode": "450101002499", "hash": "00"},
+			{"title": "b.mint", "compiledCode": "zz", "hash": "00"}
+		]}`,
+	})
+	_, err := ParsePlutusJSON(filepath.Join(dir, "plutus.json"))
+	if err == nil {
+		t.Fatal("ParsePlutusJSON succeeded, want an error")
+	}
+	for _, want := range []string{"validator a.mint: hash 00 does not match", "validator b.mint: compiled code is not hex"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Package version holds the version of gogenesis, which the Makefile sets at build time.
+package version
+
+import "fmt"
+
+// These are populated at build time through -ldflags.
+var (
+	Version    string
+	CommitHash string
+)
+
+// GetVersionString returns the release version, or "devel" for builds that are not of a
+// release tag, followed by the commit when it is known.
+func GetVersionString() string {
+	version := Version
+	if version == "" {
+		version = "devel"
+	}
+	if CommitHash == "" {
+		return version
+	}
+	return fmt.Sprintf("%s (commit %s)", version, CommitHash)
+}
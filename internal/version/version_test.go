@@ -0,0 +1,17 @@
+package version
+
+import "testing"
+
+func TestGetVersionString(t *testing.T) {
+	defer func(v, c string) { Version, CommitHash = v, c }(Version, CommitHash)
+	for _, tc := range []struct{ version, commit, want string }{
+		{"", "", "devel"},
+		{"", "abc1234", "devel (commit abc1234)"},
+		{"v1.2.0", "abc1234", "v1.2.0 (commit abc1234)"},
+	} {
+		Version, CommitHash = tc.version, tc.commit
+		if got := GetVersionString(); got != tc.want {
+			t.Errorf("GetVersionString() = %q, want %q", got, tc.want)
+		}
+	}
+}
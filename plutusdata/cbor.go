@@ -0,0 +1,367 @@
+package plutusdata
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Encode returns the CBOR encoding of d, following the conventions of the Cardano node:
+// constructor tags 121-127, 1280-1400 and 102, indefinite-length non-empty lists,
+// definite-length maps, and byte strings split into 64-byte chunks.
+func Encode(d Data) []byte {
+	return appendData(nil, d)
+}
+
+func appendData(buf []byte, d Data) []byte {
+	switch v := d.(type) {
+	case Constr:
+		switch {
+		case v.Index <= 6:
+			buf = appendHead(buf, 6, 121+v.Index)
+		case v.Index <= 127:
+			buf = appendHead(buf, 6, 1280+v.Index-7)
+		default:
+			buf = appendHead(buf, 6, 102)
+			buf = appendHead(buf, 4, 2)
+			buf = appendHead(buf, 0, v.Index)
+		}
+		return appendList(buf, v.Fields)
+	case Map:
+		buf = appendHead(buf, 5, uint64(len(v)))
+		for _, e := range v {
+			buf = appendData(buf, e.Key)
+			buf = appendData(buf, e.Value)
+		}
+		return buf
+	case List:
+		return appendList(buf, v)
+	case Integer:
+		return appendInteger(buf, v.Value)
+	case Bytes:
+		return AppendBytes(buf, v)
+	default:
+		panic(fmt.Sprintf("unsupported Plutus data value of type %T", d))
+	}
+}
+
+func appendList(buf []byte, xs []Data) []byte {
+	if len(xs) == 0 {
+		return append(buf, 0x80)
+	}
+	buf = append(buf, 0x9f)
+	for _, x := range xs {
+		buf = appendData(buf, x)
+	}
+	return append(buf, 0xff)
+}
+
+func appendInteger(buf []byte, v *big.Int) []byte {
+	if v.Sign() >= 0 {
+		if v.IsUint64() {
+			return appendHead(buf, 0, v.Uint64())
+		}
+		return AppendBytes(appendHead(buf, 6, 2), v.Bytes())
+	}
+	n := new(big.Int).Neg(v)
+	n.Sub(n, big.NewInt(1))
+	if n.IsUint64() {
+		return appendHead(buf, 1, n.Uint64())
+	}
+	return AppendBytes(appendHead(buf, 6, 3), n.Bytes())
+}
+
+// AppendBytes appends the CBOR encoding of the byte string b to buf, split into 64-byte
+// chunks if it is longer than 64 bytes.
+func AppendBytes(buf []byte, b []byte) []byte {
+	if len(b) <= 64 {
+		return append(appendHead(buf, 2, uint64(len(b))), b...)
+	}
+	buf = append(buf, 0x5f)
+	for len(b) > 0 {
+		n := len(b)
+		if n > 64 {
+			n = 64
+		}
+		buf = append(appendHead(buf, 2, uint64(n)), b[:n]...)
+		b = b[n:]
+	}
+	return append(buf, 0xff)
+}
+
+func appendHead(buf []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(buf, m|byte(n))
+	case n <= 0xff:
+		return append(buf, m|24, byte(n))
+	case n <= 0xffff:
+		return append(buf, m|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(buf, m|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	default:
+		return append(buf, m|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32),
+			byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+// Decode decodes the CBOR encoding of a data value, which must take up all of b.
+func Decode(b []byte) (Data, error) {
+	d := &decoder{buf: b}
+	v, err := d.data()
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(b) {
+		return nil, fmt.Errorf("%d trailing bytes after data", len(b)-d.pos)
+	}
+	return v, nil
+}
+
+// DecodeBytes decodes a CBOR byte string, definite or chunked, returning it along with
+// the bytes that follow it.
+func DecodeBytes(b []byte) ([]byte, []byte, error) {
+	d := &decoder{buf: b}
+	major, n, indefinite, err := d.head()
+	if err != nil {
+		return nil, nil, err
+	}
+	if major != 2 {
+		return nil, nil, fmt.Errorf("expected a byte string, found major type %d", major)
+	}
+	v, err := d.bytes(n, indefinite)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, b[d.pos:], nil
+}
+
+var errTruncated = errors.New("unexpected end of CBOR input")
+
+// maxDepth bounds the nesting of decoded values.
+const maxDepth = 1000
+
+type decoder struct {
+	buf   []byte
+	pos   int
+	depth int
+}
+
+// head reads an initial byte and its argument. indefinite is set for the indefinite
+// length marker of byte strings, arrays and maps.
+func (d *decoder) head() (major byte, n uint64, indefinite bool, err error) {
+	if d.pos >= len(d.buf) {
+		return 0, 0, false, errTruncated
+	}
+	initial := d.buf[d.pos]
+	d.pos++
+	major, info := initial>>5, initial&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), false, nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		if d.pos+size > len(d.buf) {
+			return 0, 0, false, errTruncated
+		}
+		for _, b := range d.buf[d.pos : d.pos+size] {
+			n = n<<8 | uint64(b)
+		}
+		d.pos += size
+		return major, n, false, nil
+	case info == 31 && (major == 2 || major == 4 || major == 5):
+		return major, 0, true, nil
+	default:
+		return 0, 0, false, fmt.Errorf("unsupported CBOR initial byte 0x%02x at offset %d", initial, d.pos-1)
+	}
+}
+
+// atBreak consumes the break byte ending an indefinite-length item if it comes next.
+func (d *decoder) atBreak() (bool, error) {
+	if d.pos >= len(d.buf) {
+		return false, errTruncated
+	}
+	if d.buf[d.pos] == 0xff {
+		d.pos++
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *decoder) bytes(n uint64, indefinite bool) ([]byte, error) {
+	if !indefinite {
+		if n > uint64(len(d.buf)-d.pos) {
+			return nil, errTruncated
+		}
+		out := append([]byte{}, d.buf[d.pos:d.pos+int(n)]...)
+		d.pos += int(n)
+		return out, nil
+	}
+	out := []byte{}
+	for {
+		done, err := d.atBreak()
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return out, nil
+		}
+		major, n, indefinite, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if major != 2 || indefinite {
+			return nil, fmt.Errorf("invalid chunk in indefinite-length byte string")
+		}
+		chunk, err := d.bytes(n, false)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk...)
+	}
+}
+
+// items reads the elements of an array of length n, or of an indefinite-length array.
+func (d *decoder) items(n uint64, indefinite bool) ([]Data, error) {
+	out := []Data{}
+	for i := uint64(0); indefinite || i < n; i++ {
+		if indefinite {
+			done, err := d.atBreak()
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				break
+			}
+		}
+		item, err := d.data()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (d *decoder) data() (Data, error) {
+	if d.depth++; d.depth > maxDepth {
+		return nil, fmt.Errorf("data nested deeper than %d levels", maxDepth)
+	}
+	defer func() { d.depth-- }()
+	start := d.pos
+	major, n, indefinite, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		return Integer{Value: new(big.Int).SetUint64(n)}, nil
+	case 1:
+		v := new(big.Int).SetUint64(n)
+		return Integer{Value: v.Neg(v.Add(v, big.NewInt(1)))}, nil
+	case 2:
+		b, err := d.bytes(n, indefinite)
+		if err != nil {
+			return nil, err
+		}
+		return Bytes(b), nil
+	case 4:
+		items, err := d.items(n, indefinite)
+		if err != nil {
+			return nil, err
+		}
+		return List(items), nil
+	case 5:
+		out := Map{}
+		for i := uint64(0); indefinite || i < n; i++ {
+			if indefinite {
+				done, err := d.atBreak()
+				if err != nil {
+					return nil, err
+				}
+				if done {
+					break
+				}
+			}
+			key, err := d.data()
+			if err != nil {
+				return nil, err
+			}
+			value, err := d.data()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Pair{Key: key, Value: value})
+		}
+		return out, nil
+	case 6:
+		return d.tagged(n)
+	default:
+		return nil, fmt.Errorf("unexpected CBOR major type %d at offset %d", major, start)
+	}
+}
+
+// tagged reads the content of a value with the tag n.
+func (d *decoder) tagged(n uint64) (Data, error) {
+	switch {
+	case n >= 121 && n <= 127, n >= 1280 && n <= 1400:
+		index := n - 121
+		if n >= 1280 {
+			index = n - 1280 + 7
+		}
+		fields, err := d.list()
+		if err != nil {
+			return nil, err
+		}
+		return Constr{Index: index, Fields: fields}, nil
+	case n == 102:
+		parts, err := d.list()
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("constructor with tag 102 must hold an index and fields")
+		}
+		index, ok := parts[0].(Integer)
+		if !ok || index.Value.Sign() < 0 || !index.Value.IsUint64() {
+			return nil, fmt.Errorf("invalid constructor index in tag 102")
+		}
+		fields, ok := parts[1].(List)
+		if !ok {
+			return nil, fmt.Errorf("invalid constructor fields in tag 102")
+		}
+		return Constr{Index: index.Value.Uint64(), Fields: fields}, nil
+	case n == 2, n == 3:
+		major, size, indefinite, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if major != 2 {
+			return nil, fmt.Errorf("bignum must hold a byte string")
+		}
+		b, err := d.bytes(size, indefinite)
+		if err != nil {
+			return nil, err
+		}
+		v := new(big.Int).SetBytes(b)
+		if n == 3 {
+			v.Neg(v.Add(v, big.NewInt(1)))
+		}
+		return Integer{Value: v}, nil
+	default:
+		return nil, fmt.Errorf("unsupported CBOR tag %d", n)
+	}
+}
+
+// list reads an array.
+func (d *decoder) list() ([]Data, error) {
+	major, n, indefinite, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	if major != 4 {
+		return nil, fmt.Errorf("expected an array, found major type %d", major)
+	}
+	return d.items(n, indefinite)
+}
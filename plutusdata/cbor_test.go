@@ -0,0 +1,59 @@
+package plutusdata
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	big64, _ := new(big.Int).SetString("18446744073709551616", 10)
+	tests := []struct {
+		name string
+		data Data
+		cbor string
+	}{
+		{"small constructor", Constr{Index: 0, Fields: []Data{}}, "d87980"},
+		{"constructor 7", Constr{Index: 7, Fields: []Data{NewInteger(1)}}, "d905009f01ff"},
+		{"constructor 128", Constr{Index: 128, Fields: []Data{}}, "d86682188080"},
+		{"negative integer", NewInteger(-1), "20"},
+		{"bignum", Integer{Value: big64}, "c249010000000000000000"},
+		{"negative bignum", Integer{Value: new(big.Int).Neg(new(big.Int).Add(big64, big.NewInt(1)))}, "c349010000000000000000"},
+		{"empty list", List{}, "80"},
+		{"list", List{NewInteger(1), Bytes{0xab}}, "9f0141abff"},
+		{"map", Map{{Key: Bytes{}, Value: NewInteger(2)}}, "a14002"},
+		{"chunked bytes", Bytes(bytes.Repeat([]byte{0x01}, 65)), "5f5840" + strings.Repeat("01", 64) + "4101ff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hex.EncodeToString(Encode(tt.data)); got != tt.cbor {
+				t.Errorf("Encode() = %s, want %s", got, tt.cbor)
+			}
+			raw, _ := hex.DecodeString(tt.cbor)
+			decoded, err := Decode(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(decoded, tt.data) {
+				t.Errorf("Decode() = %#v, want %#v", decoded, tt.data)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, tt := range []struct{ cbor, err string }{
+		{"d879", "unexpected end of CBOR input"},
+		{"0102", "1 trailing bytes after data"},
+		{"f6", "unexpected CBOR major type 7"},
+		{"d8799f", "unexpected end of CBOR input"},
+		{"c501", "unsupported CBOR tag 5"},
+	} {
+		raw, _ := hex.DecodeString(tt.cbor)
+		if _, err := Decode(raw); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Decode(%s) error = %v, want %q", tt.cbor, err, tt.err)
+		}
+	}
+}
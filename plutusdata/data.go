@@ -0,0 +1,92 @@
+// Package plutusdata implements Plutus data values and their on-chain CBOR encoding.
+package plutusdata
+
+import (
+	"bytes"
+	"math/big"
+)
+
+// Data is a Plutus data value: a Constr, Map, List, Integer or Bytes.
+type Data interface {
+	isData()
+}
+
+// Constr is a constructor application.
+type Constr struct {
+	Index  uint64
+	Fields []Data
+}
+
+// Map is a list of key/value pairs. Keys are not required to be unique.
+type Map []Pair
+
+// Pair is an entry of a Map.
+type Pair struct {
+	Key   Data
+	Value Data
+}
+
+// List is a list of data values.
+type List []Data
+
+// Integer is an arbitrary-precision integer.
+type Integer struct {
+	Value *big.Int
+}
+
+// Bytes is a byte string.
+type Bytes []byte
+
+func (Constr) isData()  {}
+func (Map) isData()     {}
+func (List) isData()    {}
+func (Integer) isData() {}
+func (Bytes) isData()   {}
+
+// NewInteger returns the Integer holding n.
+func NewInteger(n int64) Integer {
+	return Integer{Value: big.NewInt(n)}
+}
+
+// Equal reports whether a and b are the same data value.
+func Equal(a, b Data) bool {
+	switch a := a.(type) {
+	case Constr:
+		b, ok := b.(Constr)
+		return ok && a.Index == b.Index && equalList(a.Fields, b.Fields)
+	case Map:
+		b, ok := b.(Map)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !Equal(a[i].Key, b[i].Key) || !Equal(a[i].Value, b[i].Value) {
+				return false
+			}
+		}
+		return true
+	case List:
+		b, ok := b.(List)
+		return ok && equalList(a, b)
+	case Integer:
+		b, ok := b.(Integer)
+		return ok && a.Value.Cmp(b.Value) == 0
+	case Bytes:
+		b, ok := b.(Bytes)
+		return ok && bytes.Equal(a, b)
+	default:
+		return false
+	}
+}
+
+func equalList(a, b []Data) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
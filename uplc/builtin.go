@@ -0,0 +1,144 @@
+package uplc
+
+import "fmt"
+
+// BuiltinFunction identifies a builtin function by its index in the flat encoding.
+type BuiltinFunction uint8
+
+// The builtin functions, in the order of their flat encoding.
+const (
+	addInteger BuiltinFunction = iota
+	subtractInteger
+	multiplyInteger
+	divideInteger
+	quotientInteger
+	remainderInteger
+	modInteger
+	equalsInteger
+	lessThanInteger
+	lessThanEqualsInteger
+	appendByteString
+	consByteString
+	sliceByteString
+	lengthOfByteString
+	indexByteString
+	equalsByteString
+	lessThanByteString
+	lessThanEqualsByteString
+	sha2_256
+	sha3_256
+	blake2b_256
+	verifyEd25519Signature
+	appendString
+	equalsString
+	encodeUtf8
+	decodeUtf8
+	ifThenElse
+	chooseUnit
+	trace
+	fstPair
+	sndPair
+	chooseList
+	mkCons
+	headList
+	tailList
+	nullList
+	chooseData
+	constrData
+	mapData
+	listData
+	iData
+	bData
+	unConstrData
+	unMapData
+	unListData
+	unIData
+	unBData
+	equalsData
+	mkPairData
+	mkNilData
+	mkNilPairData
+	serialiseData
+	verifyEcdsaSecp256k1Signature
+	verifySchnorrSecp256k1Signature
+	bls12_381_G1_add
+	bls12_381_G1_neg
+	bls12_381_G1_scalarMul
+	bls12_381_G1_equal
+	bls12_381_G1_hashToGroup
+	bls12_381_G1_compress
+	bls12_381_G1_uncompress
+	bls12_381_G2_add
+	bls12_381_G2_neg
+	bls12_381_G2_scalarMul
+	bls12_381_G2_equal
+	bls12_381_G2_hashToGroup
+	bls12_381_G2_compress
+	bls12_381_G2_uncompress
+	bls12_381_millerLoop
+	bls12_381_mulMlResult
+	bls12_381_finalVerify
+	keccak_256
+	blake2b_224
+	integerToByteString
+	byteStringToInteger
+	andByteString
+	orByteString
+	xorByteString
+	complementByteString
+	readBit
+	writeBits
+	replicateByte
+	shiftByteString
+	rotateByteString
+	countSetBits
+	findFirstSetBit
+	ripemd_160
+	expModInteger
+	dropList
+	lengthOfArray
+	listToArray
+	indexArray
+	bls12_381_G1_multiScalarMul
+	bls12_381_G2_multiScalarMul
+)
+
+// builtinNames are the names of the builtin functions, by index.
+var builtinNames = []string{
+	"addInteger", "subtractInteger", "multiplyInteger", "divideInteger", "quotientInteger",
+	"remainderInteger", "modInteger", "equalsInteger", "lessThanInteger", "lessThanEqualsInteger",
+	"appendByteString", "consByteString", "sliceByteString", "lengthOfByteString", "indexByteString",
+	"equalsByteString", "lessThanByteString", "lessThanEqualsByteString", "sha2_256", "sha3_256",
+	"blake2b_256", "verifyEd25519Signature", "appendString", "equalsString", "encodeUtf8",
+	"decodeUtf8", "ifThenElse", "chooseUnit", "trace", "fstPair",
+	"sndPair", "chooseList", "mkCons", "headList", "tailList",
+	"nullList", "chooseData", "constrData", "mapData", "listData",
+	"iData", "bData", "unConstrData", "unMapData", "unListData",
+	"unIData", "unBData", "equalsData", "mkPairData", "mkNilData",
+	"mkNilPairData", "serialiseData", "verifyEcdsaSecp256k1Signature", "verifySchnorrSecp256k1Signature", "bls12_381_G1_add",
+	"bls12_381_G1_neg", "bls12_381_G1_scalarMul", "bls12_381_G1_equal", "bls12_381_G1_hashToGroup", "bls12_381_G1_compress",
+	"bls12_381_G1_uncompress", "bls12_381_G2_add", "bls12_381_G2_neg", "bls12_381_G2_scalarMul", "bls12_381_G2_equal",
+	"bls12_381_G2_hashToGroup", "bls12_381_G2_compress", "bls12_381_G2_uncompress", "bls12_381_millerLoop", "bls12_381_mulMlResult",
+	"bls12_381_finalVerify", "keccak_256", "blake2b_224", "integerToByteString", "byteStringToInteger",
+	"andByteString", "orByteString", "xorByteString", "complementByteString", "readBit",
+	"writeBits", "replicateByte", "shiftByteString", "rotateByteString", "countSetBits",
+	"findFirstSetBit", "ripemd_160", "expModInteger", "dropList", "lengthOfArray",
+	"listToArray", "indexArray", "bls12_381_G1_multiScalarMul", "bls12_381_G2_multiScalarMul",
+}
+
+func (f BuiltinFunction) String() string {
+	if int(f) < len(builtinNames) {
+		return builtinNames[f]
+	}
+	return fmt.Sprintf("builtin%d", f)
+}
+
+// LookupBuiltin returns the builtin function with the given name.
+func LookupBuiltin(name string) (BuiltinFunction, bool) {
+	for i, n := range builtinNames {
+		if n == name {
+			return BuiltinFunction(i), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,590 @@
+package uplc
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// builtinSpec describes a builtin to the machine: the number of type instantiations it
+// is forced with, the number of arguments it takes, and its semantics. run receives the
+// arguments both as values and, where they are constants, as constants.
+type builtinSpec struct {
+	forces int
+	arity  int
+	run    func(m *machine, args []value, consts []Constant) (value, error)
+}
+
+// builtinSpecs are the builtins the evaluator supports.
+var builtinSpecs = map[BuiltinFunction]builtinSpec{
+	addInteger:            integerOp(func(x, y *big.Int) (*big.Int, error) { return new(big.Int).Add(x, y), nil }),
+	subtractInteger:       integerOp(func(x, y *big.Int) (*big.Int, error) { return new(big.Int).Sub(x, y), nil }),
+	multiplyInteger:       integerOp(func(x, y *big.Int) (*big.Int, error) { return new(big.Int).Mul(x, y), nil }),
+	divideInteger:         integerOp(func(x, y *big.Int) (*big.Int, error) { q, _, err := floorDivMod(x, y); return q, err }),
+	modInteger:            integerOp(func(x, y *big.Int) (*big.Int, error) { _, r, err := floorDivMod(x, y); return r, err }),
+	quotientInteger:       integerOp(func(x, y *big.Int) (*big.Int, error) { q, _, err := truncDivMod(x, y); return q, err }),
+	remainderInteger:      integerOp(func(x, y *big.Int) (*big.Int, error) { _, r, err := truncDivMod(x, y); return r, err }),
+	equalsInteger:         integerCmp(func(c int) bool { return c == 0 }),
+	lessThanInteger:       integerCmp(func(c int) bool { return c < 0 }),
+	lessThanEqualsInteger: integerCmp(func(c int) bool { return c <= 0 }),
+
+	appendByteString: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, y, err := twoBytes(c)
+		if err != nil {
+			return nil, err
+		}
+		return con(ByteString(append(append([]byte{}, x...), y...))), nil
+	}},
+	consByteString: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		n, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		b, err := bytesArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		if !n.IsInt64() || n.Int64() < 0 || n.Int64() > 255 {
+			return nil, fmt.Errorf("byte %s out of range", n)
+		}
+		return con(ByteString(append([]byte{byte(n.Int64())}, b...))), nil
+	}},
+	sliceByteString: {arity: 3, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		start, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		n, err := integerArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := bytesArg(c, 2)
+		if err != nil {
+			return nil, err
+		}
+		// take n (drop start b): a negative start drops nothing, and n counts from the
+		// clamped start.
+		from := clamp(start, 0, int64(len(b)))
+		to := clamp(new(big.Int).Add(big.NewInt(from), n), from, int64(len(b)))
+		return con(ByteString(append([]byte{}, b[from:to]...))), nil
+	}},
+	lengthOfByteString: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		b, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return con(Integer{Value: big.NewInt(int64(len(b)))}), nil
+	}},
+	indexByteString: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		b, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		i, err := integerArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		if !i.IsInt64() || i.Int64() < 0 || i.Int64() >= int64(len(b)) {
+			return nil, fmt.Errorf("index %s out of bounds of %d bytes", i, len(b))
+		}
+		return con(Integer{Value: big.NewInt(int64(b[i.Int64()]))}), nil
+	}},
+	equalsByteString:         bytesCmp(func(c int) bool { return c == 0 }),
+	lessThanByteString:       bytesCmp(func(c int) bool { return c < 0 }),
+	lessThanEqualsByteString: bytesCmp(func(c int) bool { return c <= 0 }),
+
+	sha2_256: hash(func(b []byte) []byte { h := sha256.Sum256(b); return h[:] }),
+	sha3_256: hash(func(b []byte) []byte { h := sha3.Sum256(b); return h[:] }),
+	blake2b_256: hash(func(b []byte) []byte {
+		h := blake2b.Sum256(b)
+		return h[:]
+	}),
+	blake2b_224: hash(func(b []byte) []byte {
+		h, _ := blake2b.New(28, nil)
+		h.Write(b)
+		return h.Sum(nil)
+	}),
+	keccak_256: hash(func(b []byte) []byte {
+		h := sha3.NewLegacyKeccak256()
+		h.Write(b)
+		return h.Sum(nil)
+	}),
+	verifyEd25519Signature: {arity: 3, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		key, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := bytesArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := bytesArg(c, 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("public key of %d bytes", len(key))
+		}
+		if len(sig) != ed25519.SignatureSize {
+			return nil, fmt.Errorf("signature of %d bytes", len(sig))
+		}
+		return con(Bool(ed25519.Verify(ed25519.PublicKey(key), msg, sig))), nil
+	}},
+
+	appendString: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, ok1 := c[0].(String)
+		y, ok2 := c[1].(String)
+		if !ok1 || !ok2 {
+			return nil, errors.New("expected two strings")
+		}
+		return con(x + y), nil
+	}},
+	equalsString: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, ok1 := c[0].(String)
+		y, ok2 := c[1].(String)
+		if !ok1 || !ok2 {
+			return nil, errors.New("expected two strings")
+		}
+		return con(Bool(x == y)), nil
+	}},
+	encodeUtf8: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		s, ok := c[0].(String)
+		if !ok {
+			return nil, errors.New("expected a string")
+		}
+		return con(ByteString(s)), nil
+	}},
+	decodeUtf8: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		b, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, errors.New("invalid UTF-8")
+		}
+		return con(String(b)), nil
+	}},
+
+	ifThenElse: {forces: 1, arity: 3, run: func(_ *machine, args []value, c []Constant) (value, error) {
+		b, ok := c[0].(Bool)
+		if !ok {
+			return nil, errors.New("expected a boolean condition")
+		}
+		if b {
+			return args[1], nil
+		}
+		return args[2], nil
+	}},
+	chooseUnit: {forces: 1, arity: 2, run: func(_ *machine, args []value, c []Constant) (value, error) {
+		if _, ok := c[0].(Unit); !ok {
+			return nil, errors.New("expected unit")
+		}
+		return args[1], nil
+	}},
+	trace: {forces: 1, arity: 2, run: func(m *machine, args []value, c []Constant) (value, error) {
+		s, ok := c[0].(String)
+		if !ok {
+			return nil, errors.New("expected a string message")
+		}
+		m.logs = append(m.logs, string(s))
+		return args[1], nil
+	}},
+
+	fstPair: {forces: 2, arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		p, ok := c[0].(Pair)
+		if !ok {
+			return nil, errors.New("expected a pair")
+		}
+		return con(p.First), nil
+	}},
+	sndPair: {forces: 2, arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		p, ok := c[0].(Pair)
+		if !ok {
+			return nil, errors.New("expected a pair")
+		}
+		return con(p.Second), nil
+	}},
+
+	chooseList: {forces: 2, arity: 3, run: func(_ *machine, args []value, c []Constant) (value, error) {
+		l, ok := c[0].(List)
+		if !ok {
+			return nil, errors.New("expected a list")
+		}
+		if len(l.Items) == 0 {
+			return args[1], nil
+		}
+		return args[2], nil
+	}},
+	mkCons: {forces: 1, arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		l, ok := c[1].(List)
+		if !ok || c[0] == nil {
+			return nil, errors.New("expected a constant and a list")
+		}
+		if !c[0].Type().Equal(l.Elem) {
+			return nil, fmt.Errorf("cannot add %s to a list of %s", c[0].Type(), l.Elem)
+		}
+		return con(List{Elem: l.Elem, Items: append([]Constant{c[0]}, l.Items...)}), nil
+	}},
+	headList: {forces: 1, arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		l, err := nonEmptyList(c)
+		if err != nil {
+			return nil, err
+		}
+		return con(l.Items[0]), nil
+	}},
+	tailList: {forces: 1, arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		l, err := nonEmptyList(c)
+		if err != nil {
+			return nil, err
+		}
+		return con(List{Elem: l.Elem, Items: l.Items[1:]}), nil
+	}},
+	nullList: {forces: 1, arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		l, ok := c[0].(List)
+		if !ok {
+			return nil, errors.New("expected a list")
+		}
+		return con(Bool(len(l.Items) == 0)), nil
+	}},
+
+	chooseData: {forces: 1, arity: 6, run: func(_ *machine, args []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		switch d.(type) {
+		case plutusdata.Constr:
+			return args[1], nil
+		case plutusdata.Map:
+			return args[2], nil
+		case plutusdata.List:
+			return args[3], nil
+		case plutusdata.Integer:
+			return args[4], nil
+		default:
+			return args[5], nil
+		}
+	}},
+	constrData: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		n, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := dataList(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 || !n.IsUint64() {
+			return nil, fmt.Errorf("constructor index %s out of range", n)
+		}
+		return dataValue(plutusdata.Constr{Index: n.Uint64(), Fields: fields}), nil
+	}},
+	mapData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		l, ok := c[0].(List)
+		if !ok || !l.Elem.Equal(PairOf(Type{Kind: TypeData}, Type{Kind: TypeData})) {
+			return nil, errors.New("expected a list of pairs of data")
+		}
+		m := plutusdata.Map{}
+		for _, item := range l.Items {
+			p := item.(Pair)
+			m = append(m, plutusdata.Pair{Key: p.First.(Data).Value, Value: p.Second.(Data).Value})
+		}
+		return dataValue(m), nil
+	}},
+	listData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		items, err := dataList(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return dataValue(plutusdata.List(items)), nil
+	}},
+	iData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		n, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return dataValue(plutusdata.Integer{Value: n}), nil
+	}},
+	bData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		b, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return dataValue(plutusdata.Bytes(b)), nil
+	}},
+	unConstrData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		constr, ok := d.(plutusdata.Constr)
+		if !ok {
+			return nil, errors.New("data is not a constructor")
+		}
+		return con(Pair{
+			First:  Integer{Value: new(big.Int).SetUint64(constr.Index)},
+			Second: dataListConstant(constr.Fields),
+		}), nil
+	}},
+	unMapData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := d.(plutusdata.Map)
+		if !ok {
+			return nil, errors.New("data is not a map")
+		}
+		items := make([]Constant, len(m))
+		for i, p := range m {
+			items[i] = Pair{First: Data{Value: p.Key}, Second: Data{Value: p.Value}}
+		}
+		return con(List{Elem: PairOf(Type{Kind: TypeData}, Type{Kind: TypeData}), Items: items}), nil
+	}},
+	unListData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		l, ok := d.(plutusdata.List)
+		if !ok {
+			return nil, errors.New("data is not a list")
+		}
+		return con(dataListConstant(l)), nil
+	}},
+	unIData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		n, ok := d.(plutusdata.Integer)
+		if !ok {
+			return nil, errors.New("data is not an integer")
+		}
+		return con(Integer{Value: n.Value}), nil
+	}},
+	unBData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := d.(plutusdata.Bytes)
+		if !ok {
+			return nil, errors.New("data is not a byte string")
+		}
+		return con(ByteString(b)), nil
+	}},
+	equalsData: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		y, err := dataArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		return con(Bool(plutusdata.Equal(x, y))), nil
+	}},
+	mkPairData: {arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		y, err := dataArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		return con(Pair{First: Data{Value: x}, Second: Data{Value: y}}), nil
+	}},
+	mkNilData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		if _, ok := c[0].(Unit); !ok {
+			return nil, errors.New("expected unit")
+		}
+		return con(dataListConstant(nil)), nil
+	}},
+	mkNilPairData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		if _, ok := c[0].(Unit); !ok {
+			return nil, errors.New("expected unit")
+		}
+		return con(List{Elem: PairOf(Type{Kind: TypeData}, Type{Kind: TypeData})}), nil
+	}},
+	serialiseData: {arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		d, err := dataArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return con(ByteString(plutusdata.Encode(d))), nil
+	}},
+}
+
+func con(c Constant) value {
+	return vCon{c: c}
+}
+
+func dataValue(d plutusdata.Data) value {
+	return vCon{c: Data{Value: d}}
+}
+
+func integerOp(op func(x, y *big.Int) (*big.Int, error)) builtinSpec {
+	return builtinSpec{arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		y, err := integerArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		z, err := op(x, y)
+		if err != nil {
+			return nil, err
+		}
+		return con(Integer{Value: z}), nil
+	}}
+}
+
+func integerCmp(test func(int) bool) builtinSpec {
+	return builtinSpec{arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, err := integerArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		y, err := integerArg(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		return con(Bool(test(x.Cmp(y)))), nil
+	}}
+}
+
+func bytesCmp(test func(int) bool) builtinSpec {
+	return builtinSpec{arity: 2, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		x, y, err := twoBytes(c)
+		if err != nil {
+			return nil, err
+		}
+		return con(Bool(test(bytes.Compare(x, y)))), nil
+	}}
+}
+
+func hash(sum func([]byte) []byte) builtinSpec {
+	return builtinSpec{arity: 1, run: func(_ *machine, _ []value, c []Constant) (value, error) {
+		b, err := bytesArg(c, 0)
+		if err != nil {
+			return nil, err
+		}
+		return con(ByteString(sum(b))), nil
+	}}
+}
+
+var errDivisionByZero = errors.New("division by zero")
+
+// floorDivMod divides rounding towards negative infinity, as Haskell's div and mod.
+func floorDivMod(x, y *big.Int) (*big.Int, *big.Int, error) {
+	q, r, err := truncDivMod(x, y)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.Sign() != 0 && r.Sign() != y.Sign() {
+		q.Sub(q, big.NewInt(1))
+		r.Add(r, y)
+	}
+	return q, r, nil
+}
+
+// truncDivMod divides rounding towards zero, as Haskell's quot and rem.
+func truncDivMod(x, y *big.Int) (*big.Int, *big.Int, error) {
+	if y.Sign() == 0 {
+		return nil, nil, errDivisionByZero
+	}
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	return q, r, nil
+}
+
+// clamp returns n bounded to [lo, hi].
+func clamp(n *big.Int, lo, hi int64) int64 {
+	if n.Cmp(big.NewInt(lo)) < 0 {
+		return lo
+	}
+	if n.Cmp(big.NewInt(hi)) > 0 {
+		return hi
+	}
+	return n.Int64()
+}
+
+func integerArg(c []Constant, i int) (*big.Int, error) {
+	n, ok := c[i].(Integer)
+	if !ok {
+		return nil, fmt.Errorf("argument %d is not an integer", i+1)
+	}
+	return n.Value, nil
+}
+
+func bytesArg(c []Constant, i int) ([]byte, error) {
+	b, ok := c[i].(ByteString)
+	if !ok {
+		return nil, fmt.Errorf("argument %d is not a byte string", i+1)
+	}
+	return b, nil
+}
+
+func twoBytes(c []Constant) ([]byte, []byte, error) {
+	x, err := bytesArg(c, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	y, err := bytesArg(c, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return x, y, nil
+}
+
+func dataArg(c []Constant, i int) (plutusdata.Data, error) {
+	d, ok := c[i].(Data)
+	if !ok {
+		return nil, fmt.Errorf("argument %d is not data", i+1)
+	}
+	return d.Value, nil
+}
+
+// dataList returns the items of a list of data.
+func dataList(c []Constant, i int) ([]plutusdata.Data, error) {
+	l, ok := c[i].(List)
+	if !ok || l.Elem.Kind != TypeData {
+		return nil, fmt.Errorf("argument %d is not a list of data", i+1)
+	}
+	items := make([]plutusdata.Data, len(l.Items))
+	for j, item := range l.Items {
+		items[j] = item.(Data).Value
+	}
+	return items, nil
+}
+
+func dataListConstant(items []plutusdata.Data) List {
+	l := List{Elem: Type{Kind: TypeData}, Items: make([]Constant, len(items))}
+	for i, item := range items {
+		l.Items[i] = Data{Value: item}
+	}
+	return l
+}
+
+func nonEmptyList(c []Constant) (List, error) {
+	l, ok := c[0].(List)
+	if !ok {
+		return List{}, errors.New("expected a list")
+	}
+	if len(l.Items) == 0 {
+		return List{}, errors.New("empty list")
+	}
+	return l, nil
+}
@@ -0,0 +1,228 @@
+package uplc
+
+import (
+	"unicode/utf8"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Budget is an amount of execution units: CPU steps and memory units.
+type Budget struct {
+	CPU int64
+	Mem int64
+}
+
+// DefaultBudget is the maximum budget of a transaction on mainnet.
+var DefaultBudget = Budget{CPU: 10_000_000_000, Mem: 14_000_000}
+
+func (b Budget) add(c Budget) Budget {
+	return Budget{CPU: b.CPU + c.CPU, Mem: b.Mem + c.Mem}
+}
+
+// exceeds reports whether b exceeds limit in either unit.
+func (b Budget) exceeds(limit Budget) bool {
+	return b.CPU > limit.CPU || b.Mem > limit.Mem
+}
+
+// Machine costs of the Plutus V3 cost model: the startup cost of the machine, and the
+// cost of each step it takes.
+var (
+	startupCost = Budget{CPU: 100, Mem: 100}
+	stepCost    = Budget{CPU: 16000, Mem: 100}
+)
+
+// costFunc is the cost of a builtin as a function of the sizes of its arguments.
+type costFunc func(sizes []int64) int64
+
+func constantCost(c int64) costFunc {
+	return func([]int64) int64 { return c }
+}
+
+func linearIn(arg int, intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*sizes[arg] }
+}
+
+func addedSizes(intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*(sizes[0]+sizes[1]) }
+}
+
+func multipliedSizes(intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*sizes[0]*sizes[1] }
+}
+
+func maxSize(intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*max(sizes[0], sizes[1]) }
+}
+
+func minSize(intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*min(sizes[0], sizes[1]) }
+}
+
+func subtractedSizes(intercept, slope, minimum int64) costFunc {
+	return func(sizes []int64) int64 { return intercept + slope*max(sizes[0]-sizes[1], minimum) }
+}
+
+// linearOnDiagonal costs equal-sized arguments linearly, and others a constant, as
+// comparing arguments of different sizes is immediate.
+func linearOnDiagonal(constant, intercept, slope int64) costFunc {
+	return func(sizes []int64) int64 {
+		if sizes[0] == sizes[1] {
+			return intercept + slope*sizes[0]
+		}
+		return constant
+	}
+}
+
+// constAboveDiagonal costs a constant when the first argument is smaller than the
+// second, as dividing by a larger number is immediate.
+func constAboveDiagonal(constant int64, model costFunc) costFunc {
+	return func(sizes []int64) int64 {
+		if sizes[0] < sizes[1] {
+			return constant
+		}
+		return model(sizes)
+	}
+}
+
+// quadraticInXAndY costs c00 + c10*x + c01*y + c20*x² + c11*x*y + c02*y² for argument
+// sizes x and y, and at least minimum.
+func quadraticInXAndY(c00, c10, c01, c20, c11, c02, minimum int64) costFunc {
+	return func(sizes []int64) int64 {
+		x, y := sizes[0], sizes[1]
+		return max(minimum, c00+c10*x+c01*y+c20*x*x+c11*x*y+c02*y*y)
+	}
+}
+
+// divisionCost is the CPU cost of the integer division builtins.
+var divisionCost = constAboveDiagonal(85848, quadraticInXAndY(123203, 1716, 7305, 57, 549, -900, 85848))
+
+// builtinCost is the cost model of a builtin.
+type builtinCost struct {
+	cpu, mem costFunc
+}
+
+// builtinCosts are the costs of the builtins the evaluator supports, with the default
+// parameters of the Plutus V3 cost model.
+var builtinCosts = map[BuiltinFunction]builtinCost{
+	addInteger:               {maxSize(100788, 420), maxSize(1, 1)},
+	subtractInteger:          {maxSize(100788, 420), maxSize(1, 1)},
+	multiplyInteger:          {multipliedSizes(90434, 519), addedSizes(0, 1)},
+	divideInteger:            {divisionCost, subtractedSizes(0, 1, 1)},
+	quotientInteger:          {divisionCost, subtractedSizes(0, 1, 1)},
+	remainderInteger:         {divisionCost, linearIn(1, 0, 1)},
+	modInteger:               {divisionCost, linearIn(1, 0, 1)},
+	equalsInteger:            {minSize(51775, 558), constantCost(1)},
+	lessThanInteger:          {minSize(44749, 541), constantCost(1)},
+	lessThanEqualsInteger:    {minSize(43285, 552), constantCost(1)},
+	appendByteString:         {addedSizes(1000, 173), addedSizes(0, 1)},
+	consByteString:           {linearIn(1, 72010, 178), addedSizes(0, 1)},
+	sliceByteString:          {linearIn(2, 20467, 1), linearIn(2, 4, 0)},
+	lengthOfByteString:       {constantCost(22100), constantCost(10)},
+	indexByteString:          {constantCost(13169), constantCost(4)},
+	equalsByteString:         {linearOnDiagonal(24548, 29498, 38), constantCost(1)},
+	lessThanByteString:       {minSize(28999, 74), constantCost(1)},
+	lessThanEqualsByteString: {minSize(28999, 74), constantCost(1)},
+	sha2_256:                 {linearIn(0, 270652, 22588), constantCost(4)},
+	sha3_256:                 {linearIn(0, 1457325, 64566), constantCost(4)},
+	blake2b_256:              {linearIn(0, 201305, 8356), constantCost(4)},
+	blake2b_224:              {linearIn(0, 207616, 8310), constantCost(4)},
+	keccak_256:               {linearIn(0, 2261318, 64571), constantCost(4)},
+	verifyEd25519Signature:   {linearIn(1, 53384111, 14333), constantCost(10)},
+	appendString:             {addedSizes(1000, 59957), addedSizes(4, 1)},
+	equalsString:             {linearOnDiagonal(39184, 1000, 60594), constantCost(1)},
+	encodeUtf8:               {linearIn(0, 1000, 42921), linearIn(0, 4, 2)},
+	decodeUtf8:               {linearIn(0, 91189, 769), linearIn(0, 4, 2)},
+	ifThenElse:               {constantCost(76049), constantCost(1)},
+	chooseUnit:               {constantCost(61462), constantCost(4)},
+	trace:                    {constantCost(59498), constantCost(32)},
+	fstPair:                  {constantCost(141895), constantCost(32)},
+	sndPair:                  {constantCost(141992), constantCost(32)},
+	chooseList:               {constantCost(132994), constantCost(32)},
+	mkCons:                   {constantCost(72362), constantCost(32)},
+	headList:                 {constantCost(83150), constantCost(32)},
+	tailList:                 {constantCost(81663), constantCost(32)},
+	nullList:                 {constantCost(74433), constantCost(32)},
+	chooseData:               {constantCost(94375), constantCost(32)},
+	constrData:               {constantCost(22151), constantCost(32)},
+	mapData:                  {constantCost(68246), constantCost(32)},
+	listData:                 {constantCost(33852), constantCost(32)},
+	iData:                    {constantCost(15299), constantCost(32)},
+	bData:                    {constantCost(11183), constantCost(32)},
+	unConstrData:             {constantCost(24588), constantCost(32)},
+	unMapData:                {constantCost(24623), constantCost(32)},
+	unListData:               {constantCost(25933), constantCost(32)},
+	unIData:                  {constantCost(20744), constantCost(32)},
+	unBData:                  {constantCost(20142), constantCost(32)},
+	equalsData:               {minSize(898148, 27279), constantCost(1)},
+	mkPairData:               {constantCost(11546), constantCost(32)},
+	mkNilData:                {constantCost(7243), constantCost(32)},
+	mkNilPairData:            {constantCost(7391), constantCost(32)},
+	serialiseData:            {linearIn(0, 955506, 213312), linearIn(0, 0, 2)},
+}
+
+// memory returns the size of a constant in memory units, the measure builtin costs are
+// functions of.
+func memory(c Constant) int64 {
+	switch c := c.(type) {
+	case Integer:
+		if c.Value.Sign() == 0 {
+			return 1
+		}
+		return int64((c.Value.BitLen()-1)/64 + 1)
+	case ByteString:
+		return bytesMemory(c)
+	case String:
+		return int64(utf8.RuneCountInString(string(c)))
+	case List:
+		var total int64
+		for _, item := range c.Items {
+			total += memory(item)
+		}
+		return total
+	case Pair:
+		return 1 + memory(c.First) + memory(c.Second)
+	case Data:
+		return dataMemory(c.Value)
+	default:
+		return 1
+	}
+}
+
+func bytesMemory(b []byte) int64 {
+	if len(b) == 0 {
+		return 1
+	}
+	return int64((len(b)-1)/8 + 1)
+}
+
+// dataMemory is the size of Plutus data: 4 units per node, plus the size of the integers
+// and byte strings it holds.
+func dataMemory(d plutusdata.Data) int64 {
+	const node = 4
+	switch d := d.(type) {
+	case plutusdata.Constr:
+		total := int64(node)
+		for _, f := range d.Fields {
+			total += dataMemory(f)
+		}
+		return total
+	case plutusdata.Map:
+		total := int64(node)
+		for _, p := range d {
+			total += dataMemory(p.Key) + dataMemory(p.Value)
+		}
+		return total
+	case plutusdata.List:
+		total := int64(node)
+		for _, item := range d {
+			total += dataMemory(item)
+		}
+		return total
+	case plutusdata.Integer:
+		return node + memory(Integer{Value: d.Value})
+	case plutusdata.Bytes:
+		return node + bytesMemory(d)
+	default:
+		return node
+	}
+}
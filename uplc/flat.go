@@ -0,0 +1,526 @@
+package uplc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Flat term tags.
+const (
+	tagVar = iota
+	tagDelay
+	tagLambda
+	tagApply
+	tagConst
+	tagForce
+	tagError
+	tagBuiltin
+	tagConstr
+	tagCase
+)
+
+const (
+	termTagWidth    = 4
+	typeTagWidth    = 4
+	builtinTagWidth = 7
+)
+
+var errFlatTruncated = errors.New("unexpected end of flat input")
+
+// DecodeFlat decodes a program from its flat encoding.
+func DecodeFlat(b []byte) (*Program, error) {
+	r := &bitReader{buf: b}
+	var p Program
+	var err error
+	if p.Version.Major, err = r.natural64(); err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+	if p.Version.Minor, err = r.natural64(); err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+	if p.Version.Patch, err = r.natural64(); err != nil {
+		return nil, fmt.Errorf("invalid version: %w", err)
+	}
+	if p.Term, err = r.term(); err != nil {
+		return nil, err
+	}
+	if err := r.filler(); err != nil {
+		return nil, err
+	}
+	if r.pos/8 != len(b) {
+		return nil, fmt.Errorf("%d trailing bytes after program", len(b)-r.pos/8)
+	}
+	return &p, nil
+}
+
+// EncodeFlat returns the flat encoding of p.
+func EncodeFlat(p *Program) []byte {
+	w := &bitWriter{}
+	w.natural(new(big.Int).SetUint64(p.Version.Major))
+	w.natural(new(big.Int).SetUint64(p.Version.Minor))
+	w.natural(new(big.Int).SetUint64(p.Version.Patch))
+	w.term(p.Term)
+	w.filler()
+	return w.buf
+}
+
+//
+// --- Decoding ---
+//
+
+type bitReader struct {
+	buf []byte
+	pos int // in bits
+}
+
+func (r *bitReader) bit() (bool, error) {
+	if r.pos >= len(r.buf)*8 {
+		return false, errFlatTruncated
+	}
+	set := r.buf[r.pos/8]&(0x80>>(r.pos%8)) != 0
+	r.pos++
+	return set, nil
+}
+
+func (r *bitReader) bits(n int) (uint64, error) {
+	var v uint64
+	for i := 0; i < n; i++ {
+		set, err := r.bit()
+		if err != nil {
+			return 0, err
+		}
+		v <<= 1
+		if set {
+			v |= 1
+		}
+	}
+	return v, nil
+}
+
+// natural reads a natural number as little-endian 7-bit groups, each preceded by a bit
+// telling whether more groups follow.
+func (r *bitReader) natural() (*big.Int, error) {
+	n := new(big.Int)
+	for shift := uint(0); ; shift += 7 {
+		group, err := r.bits(8)
+		if err != nil {
+			return nil, err
+		}
+		n.Or(n, new(big.Int).Lsh(big.NewInt(int64(group&0x7f)), shift))
+		if group&0x80 == 0 {
+			return n, nil
+		}
+	}
+}
+
+func (r *bitReader) natural64() (uint64, error) {
+	n, err := r.natural()
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("natural %s overflows 64 bits", n)
+	}
+	return n.Uint64(), nil
+}
+
+func (r *bitReader) integer() (*big.Int, error) {
+	n, err := r.natural()
+	if err != nil {
+		return nil, err
+	}
+	// Zigzag: 2n for n >= 0, -2n-1 for n < 0.
+	if n.Bit(0) == 0 {
+		return n.Rsh(n, 1), nil
+	}
+	n.Rsh(n, 1)
+	return n.Neg(n.Add(n, big.NewInt(1))), nil
+}
+
+// filler skips the padding that aligns the next item to a byte: zero bits followed by a
+// one bit.
+func (r *bitReader) filler() error {
+	for {
+		set, err := r.bit()
+		if err != nil {
+			return err
+		}
+		if set {
+			break
+		}
+	}
+	if r.pos%8 != 0 {
+		return fmt.Errorf("misaligned filler at bit %d", r.pos)
+	}
+	return nil
+}
+
+func (r *bitReader) bytes() ([]byte, error) {
+	if err := r.filler(); err != nil {
+		return nil, err
+	}
+	out := []byte{}
+	for {
+		if r.pos/8 >= len(r.buf) {
+			return nil, errFlatTruncated
+		}
+		n := int(r.buf[r.pos/8])
+		r.pos += 8
+		if n == 0 {
+			return out, nil
+		}
+		if r.pos/8+n > len(r.buf) {
+			return nil, errFlatTruncated
+		}
+		out = append(out, r.buf[r.pos/8:r.pos/8+n]...)
+		r.pos += n * 8
+	}
+}
+
+// list reads the elements of a list, each preceded by a one bit and the whole followed
+// by a zero bit.
+func (r *bitReader) list(item func() error) error {
+	for {
+		more, err := r.bit()
+		if err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+		if err := item(); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *bitReader) terms() ([]Term, error) {
+	out := []Term{}
+	err := r.list(func() error {
+		t, err := r.term()
+		out = append(out, t)
+		return err
+	})
+	return out, err
+}
+
+func (r *bitReader) term() (Term, error) {
+	tag, err := r.bits(termTagWidth)
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case tagVar:
+		index, err := r.natural64()
+		return Var{Index: index}, err
+	case tagDelay:
+		t, err := r.term()
+		return Delay{Term: t}, err
+	case tagLambda:
+		body, err := r.term()
+		return Lambda{Body: body}, err
+	case tagApply:
+		fn, err := r.term()
+		if err != nil {
+			return nil, err
+		}
+		arg, err := r.term()
+		return Apply{Function: fn, Argument: arg}, err
+	case tagConst:
+		c, err := r.constant()
+		return Const{Value: c}, err
+	case tagForce:
+		t, err := r.term()
+		return Force{Term: t}, err
+	case tagError:
+		return Error{}, nil
+	case tagBuiltin:
+		index, err := r.bits(builtinTagWidth)
+		if err != nil {
+			return nil, err
+		}
+		if int(index) >= len(builtinNames) {
+			return nil, fmt.Errorf("unknown builtin function %d", index)
+		}
+		return Builtin{Function: BuiltinFunction(index)}, nil
+	case tagConstr:
+		constrTag, err := r.natural64()
+		if err != nil {
+			return nil, err
+		}
+		fields, err := r.terms()
+		return Constr{Tag: constrTag, Fields: fields}, err
+	case tagCase:
+		scrutinee, err := r.term()
+		if err != nil {
+			return nil, err
+		}
+		branches, err := r.terms()
+		return Case{Scrutinee: scrutinee, Branches: branches}, err
+	default:
+		return nil, fmt.Errorf("unknown term tag %d at bit %d", tag, r.pos-termTagWidth)
+	}
+}
+
+func (r *bitReader) constant() (Constant, error) {
+	var tags []TypeKind
+	err := r.list(func() error {
+		tag, err := r.bits(typeTagWidth)
+		tags = append(tags, TypeKind(tag))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	typ, rest, err := parseType(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("trailing type tags in constant of type %s", typ)
+	}
+	return r.value(typ)
+}
+
+// parseType parses the type at the start of tags, returning it and the remaining tags.
+func parseType(tags []TypeKind) (Type, []TypeKind, error) {
+	if len(tags) == 0 {
+		return Type{}, nil, fmt.Errorf("missing constant type")
+	}
+	switch tags[0] {
+	case TypeInteger, TypeByteString, TypeString, TypeUnit, TypeBool, TypeData:
+		return Type{Kind: tags[0]}, tags[1:], nil
+	case typeApplication:
+		if len(tags) > 1 && tags[1] == TypeList {
+			elem, rest, err := parseType(tags[2:])
+			return ListOf(elem), rest, err
+		}
+		if len(tags) > 2 && tags[1] == typeApplication && tags[2] == TypePair {
+			first, rest, err := parseType(tags[3:])
+			if err != nil {
+				return Type{}, nil, err
+			}
+			second, rest, err := parseType(rest)
+			return PairOf(first, second), rest, err
+		}
+	}
+	return Type{}, nil, fmt.Errorf("unsupported constant type tags %v", tags)
+}
+
+func (r *bitReader) value(typ Type) (Constant, error) {
+	switch typ.Kind {
+	case TypeInteger:
+		v, err := r.integer()
+		return Integer{Value: v}, err
+	case TypeByteString:
+		b, err := r.bytes()
+		return ByteString(b), err
+	case TypeString:
+		b, err := r.bytes()
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, fmt.Errorf("invalid UTF-8 in string constant")
+		}
+		return String(b), nil
+	case TypeUnit:
+		return Unit{}, nil
+	case TypeBool:
+		b, err := r.bit()
+		return Bool(b), err
+	case TypeList:
+		items := []Constant{}
+		err := r.list(func() error {
+			item, err := r.value(typ.Args[0])
+			items = append(items, item)
+			return err
+		})
+		return List{Elem: typ.Args[0], Items: items}, err
+	case TypePair:
+		first, err := r.value(typ.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		second, err := r.value(typ.Args[1])
+		return Pair{First: first, Second: second}, err
+	case TypeData:
+		b, err := r.bytes()
+		if err != nil {
+			return nil, err
+		}
+		d, err := plutusdata.Decode(b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data constant: %w", err)
+		}
+		return Data{Value: d}, nil
+	default:
+		return nil, fmt.Errorf("unsupported constant type %s", typ)
+	}
+}
+
+//
+// --- Encoding ---
+//
+
+type bitWriter struct {
+	buf []byte
+	pos int // in bits
+}
+
+func (w *bitWriter) bit(set bool) {
+	if w.pos%8 == 0 {
+		w.buf = append(w.buf, 0)
+	}
+	if set {
+		w.buf[len(w.buf)-1] |= 0x80 >> (w.pos % 8)
+	}
+	w.pos++
+}
+
+func (w *bitWriter) bits(n int, v uint64) {
+	for i := n - 1; i >= 0; i-- {
+		w.bit(v&(1<<i) != 0)
+	}
+}
+
+func (w *bitWriter) natural(n *big.Int) {
+	n = new(big.Int).Set(n)
+	for {
+		group := n.Uint64() & 0x7f
+		n.Rsh(n, 7)
+		if n.Sign() != 0 {
+			w.bits(8, group|0x80)
+			continue
+		}
+		w.bits(8, group)
+		return
+	}
+}
+
+func (w *bitWriter) integer(v *big.Int) {
+	n := new(big.Int).Lsh(v, 1)
+	if v.Sign() < 0 {
+		n.Neg(n).Sub(n, big.NewInt(1))
+	}
+	w.natural(n)
+}
+
+func (w *bitWriter) filler() {
+	for w.pos%8 != 7 {
+		w.bit(false)
+	}
+	w.bit(true)
+}
+
+func (w *bitWriter) bytes(b []byte) {
+	w.filler()
+	for len(b) > 0 {
+		n := len(b)
+		if n > 255 {
+			n = 255
+		}
+		w.buf = append(w.buf, byte(n))
+		w.buf = append(w.buf, b[:n]...)
+		w.pos += (n + 1) * 8
+		b = b[n:]
+	}
+	w.buf = append(w.buf, 0)
+	w.pos += 8
+}
+
+func (w *bitWriter) terms(ts []Term) {
+	for _, t := range ts {
+		w.bit(true)
+		w.term(t)
+	}
+	w.bit(false)
+}
+
+func (w *bitWriter) term(t Term) {
+	switch t := t.(type) {
+	case Var:
+		w.bits(termTagWidth, tagVar)
+		w.natural(new(big.Int).SetUint64(t.Index))
+	case Delay:
+		w.bits(termTagWidth, tagDelay)
+		w.term(t.Term)
+	case Lambda:
+		w.bits(termTagWidth, tagLambda)
+		w.term(t.Body)
+	case Apply:
+		w.bits(termTagWidth, tagApply)
+		w.term(t.Function)
+		w.term(t.Argument)
+	case Const:
+		w.bits(termTagWidth, tagConst)
+		for _, tag := range typeTags(t.Value.Type(), nil) {
+			w.bit(true)
+			w.bits(typeTagWidth, uint64(tag))
+		}
+		w.bit(false)
+		w.value(t.Value)
+	case Force:
+		w.bits(termTagWidth, tagForce)
+		w.term(t.Term)
+	case Error:
+		w.bits(termTagWidth, tagError)
+	case Builtin:
+		w.bits(termTagWidth, tagBuiltin)
+		w.bits(builtinTagWidth, uint64(t.Function))
+	case Constr:
+		w.bits(termTagWidth, tagConstr)
+		w.natural(new(big.Int).SetUint64(t.Tag))
+		w.terms(t.Fields)
+	case Case:
+		w.bits(termTagWidth, tagCase)
+		w.term(t.Scrutinee)
+		w.terms(t.Branches)
+	default:
+		panic(fmt.Sprintf("unsupported term of type %T", t))
+	}
+}
+
+// typeTags appends the flat tags of typ to tags.
+func typeTags(typ Type, tags []TypeKind) []TypeKind {
+	switch typ.Kind {
+	case TypeList:
+		return typeTags(typ.Args[0], append(tags, typeApplication, TypeList))
+	case TypePair:
+		tags = typeTags(typ.Args[0], append(tags, typeApplication, typeApplication, TypePair))
+		return typeTags(typ.Args[1], tags)
+	default:
+		return append(tags, typ.Kind)
+	}
+}
+
+func (w *bitWriter) value(c Constant) {
+	switch c := c.(type) {
+	case Integer:
+		w.integer(c.Value)
+	case ByteString:
+		w.bytes(c)
+	case String:
+		w.bytes([]byte(c))
+	case Unit:
+	case Bool:
+		w.bit(bool(c))
+	case List:
+		for _, item := range c.Items {
+			w.bit(true)
+			w.value(item)
+		}
+		w.bit(false)
+	case Pair:
+		w.value(c.First)
+		w.value(c.Second)
+	case Data:
+		w.bytes(plutusdata.Encode(c.Value))
+	default:
+		panic(fmt.Sprintf("constant of type %T has no flat encoding", c))
+	}
+}
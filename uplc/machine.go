@@ -0,0 +1,455 @@
+package uplc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EvalResult is the outcome of evaluating a program.
+type EvalResult struct {
+	// Term is the term the program evaluated to, or nil if evaluation failed.
+	Term Term
+	// Budget is the budget spent, including on a failed evaluation.
+	Budget Budget
+	// Logs are the messages traced during evaluation.
+	Logs []string
+}
+
+// ErrOutOfBudget is returned when evaluation spends more than its budget.
+var ErrOutOfBudget = errors.New("out of budget")
+
+// Eval evaluates p, a program of Plutus version plutusVersion, on the CEK machine,
+// spending at most limit. A failed evaluation returns an error along with the result,
+// whose budget and logs tell how far it went.
+//
+// Only Plutus V3 programs are supported: V1 and V2 have their own cost models and
+// builtin semantics, such as consByteString reducing its byte modulo 256, which are not
+// implemented.
+func Eval(p *Program, plutusVersion string, limit Budget) (*EvalResult, error) {
+	if plutusVersion != "v3" {
+		return nil, fmt.Errorf("cannot evaluate Plutus %s programs: only the Plutus v3 cost model and builtin semantics are implemented", plutusVersion)
+	}
+	if err := CheckBuiltins(p); err != nil {
+		return nil, err
+	}
+	m := &machine{limit: limit}
+	v, err := m.run(p.Term)
+	result := &EvalResult{Budget: m.spent, Logs: m.logs}
+	if err != nil {
+		return result, err
+	}
+	result.Term = discharge(v)
+	return result, nil
+}
+
+// CheckBuiltins returns an error naming the builtins p uses that the evaluator does not
+// implement, so that a program is rejected before it runs rather than when it reaches
+// one of them. These are the secp256k1 signatures, BLS12-381, the conversions between
+// integers and byte strings, the bitwise operations, ripemd_160, expModInteger, dropList
+// and arrays.
+func CheckBuiltins(p *Program) error {
+	unsupported := make(map[BuiltinFunction]bool)
+	collectUnsupported(p.Term, unsupported)
+	if len(unsupported) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(unsupported))
+	for fn := range unsupported {
+		names = append(names, fn.String())
+	}
+	sort.Strings(names)
+	return fmt.Errorf("the program uses builtins the evaluator does not support: %s", strings.Join(names, ", "))
+}
+
+func collectUnsupported(t Term, unsupported map[BuiltinFunction]bool) {
+	switch t := t.(type) {
+	case Builtin:
+		if _, ok := builtinSpecs[t.Function]; !ok {
+			unsupported[t.Function] = true
+		}
+	case Lambda:
+		collectUnsupported(t.Body, unsupported)
+	case Delay:
+		collectUnsupported(t.Term, unsupported)
+	case Force:
+		collectUnsupported(t.Term, unsupported)
+	case Apply:
+		collectUnsupported(t.Function, unsupported)
+		collectUnsupported(t.Argument, unsupported)
+	case Constr:
+		for _, field := range t.Fields {
+			collectUnsupported(field, unsupported)
+		}
+	case Case:
+		collectUnsupported(t.Scrutinee, unsupported)
+		for _, branch := range t.Branches {
+			collectUnsupported(branch, unsupported)
+		}
+	}
+}
+
+//
+// --- Values ---
+//
+
+// value is the result of evaluating a term.
+type value interface {
+	isValue()
+}
+
+type vCon struct {
+	c Constant
+}
+
+type vDelay struct {
+	body Term
+	env  *env
+}
+
+type vLambda struct {
+	body Term
+	env  *env
+}
+
+// vBuiltin is a builtin applied to fewer arguments than it takes. forces counts the
+// type instantiations it still expects.
+type vBuiltin struct {
+	fn     BuiltinFunction
+	forces int
+	args   []value
+}
+
+type vConstr struct {
+	tag    uint64
+	fields []value
+}
+
+func (vCon) isValue()     {}
+func (vDelay) isValue()   {}
+func (vLambda) isValue()  {}
+func (vBuiltin) isValue() {}
+func (vConstr) isValue()  {}
+
+// env binds the variables of enclosing lambdas, innermost first.
+type env struct {
+	value value
+	next  *env
+}
+
+func (e *env) lookup(index uint64) (value, bool) {
+	for ; e != nil; e = e.next {
+		if index == 1 {
+			return e.value, true
+		}
+		index--
+	}
+	return nil, false
+}
+
+//
+// --- Machine ---
+//
+
+// frame is a continuation frame of the machine.
+type frame interface {
+	isFrame()
+}
+
+// frameApplyArg awaits the function of an application, to then evaluate its argument.
+type frameApplyArg struct {
+	arg Term
+	env *env
+}
+
+// frameApplyFun awaits the argument of an application of fun.
+type frameApplyFun struct {
+	fun value
+}
+
+// frameApplyTo awaits a function to apply to arg, as when a case branch is applied to
+// the fields of a constructor.
+type frameApplyTo struct {
+	arg value
+}
+
+type frameForce struct{}
+
+// frameConstr awaits the next field of a constructor.
+type frameConstr struct {
+	tag    uint64
+	done   []value
+	rest   []Term
+	env    *env
+	fields int
+}
+
+type frameCase struct {
+	branches []Term
+	env      *env
+}
+
+func (frameApplyArg) isFrame() {}
+func (frameApplyFun) isFrame() {}
+func (frameApplyTo) isFrame()  {}
+func (frameForce) isFrame()    {}
+func (frameConstr) isFrame()   {}
+func (frameCase) isFrame()     {}
+
+type machine struct {
+	limit Budget
+	spent Budget
+	logs  []string
+	stack []frame
+}
+
+func (m *machine) spend(b Budget) error {
+	m.spent = m.spent.add(b)
+	if m.spent.exceeds(m.limit) {
+		return ErrOutOfBudget
+	}
+	return nil
+}
+
+// run evaluates term in the empty environment. It alternates between computing terms
+// and returning values to the frame on top of the stack, until the stack is empty.
+func (m *machine) run(term Term) (value, error) {
+	if err := m.spend(startupCost); err != nil {
+		return nil, err
+	}
+	var e *env
+	for {
+		v, err := m.compute(term, e)
+		if err != nil {
+			return nil, err
+		}
+		for next := false; !next; {
+			if len(m.stack) == 0 {
+				return v, nil
+			}
+			top := m.stack[len(m.stack)-1]
+			m.stack = m.stack[:len(m.stack)-1]
+			term, e, v, next, err = m.ret(top, v)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// compute computes term until it yields a value, pushing a frame for the rest of the
+// work whenever it descends into a subterm.
+func (m *machine) compute(term Term, e *env) (value, error) {
+	for {
+		if _, ok := term.(Error); ok {
+			return nil, errors.New("error term evaluated")
+		}
+		if err := m.spend(stepCost); err != nil {
+			return nil, err
+		}
+		switch t := term.(type) {
+		case Var:
+			v, ok := e.lookup(t.Index)
+			if !ok {
+				return nil, fmt.Errorf("free variable %d", t.Index)
+			}
+			return v, nil
+		case Const:
+			return vCon{c: t.Value}, nil
+		case Lambda:
+			return vLambda{body: t.Body, env: e}, nil
+		case Delay:
+			return vDelay{body: t.Term, env: e}, nil
+		case Builtin:
+			spec, ok := builtinSpecs[t.Function]
+			if !ok {
+				return nil, fmt.Errorf("builtin %s is not supported by the evaluator", t.Function)
+			}
+			return vBuiltin{fn: t.Function, forces: spec.forces}, nil
+		case Apply:
+			m.stack = append(m.stack, frameApplyArg{arg: t.Argument, env: e})
+			term = t.Function
+		case Force:
+			m.stack = append(m.stack, frameForce{})
+			term = t.Term
+		case Constr:
+			if len(t.Fields) == 0 {
+				return vConstr{tag: t.Tag}, nil
+			}
+			m.stack = append(m.stack, frameConstr{tag: t.Tag, rest: t.Fields[1:], env: e, fields: len(t.Fields)})
+			term = t.Fields[0]
+		case Case:
+			m.stack = append(m.stack, frameCase{branches: t.Branches, env: e})
+			term = t.Scrutinee
+		default:
+			return nil, fmt.Errorf("unknown term %T", term)
+		}
+	}
+}
+
+// ret returns v to frame f. It either yields a value for the next frame, or a term to
+// compute next, in which case next is set.
+func (m *machine) ret(f frame, v value) (term Term, e *env, result value, next bool, err error) {
+	switch f := f.(type) {
+	case frameApplyArg:
+		m.stack = append(m.stack, frameApplyFun{fun: v})
+		return f.arg, f.env, nil, true, nil
+	case frameApplyFun:
+		return m.apply(f.fun, v)
+	case frameApplyTo:
+		return m.apply(v, f.arg)
+	case frameForce:
+		return m.force(v)
+	case frameConstr:
+		done := append(append(make([]value, 0, f.fields), f.done...), v)
+		if len(f.rest) == 0 {
+			return nil, nil, vConstr{tag: f.tag, fields: done}, false, nil
+		}
+		m.stack = append(m.stack, frameConstr{tag: f.tag, done: done, rest: f.rest[1:], env: f.env, fields: f.fields})
+		return f.rest[0], f.env, nil, true, nil
+	case frameCase:
+		c, ok := v.(vConstr)
+		if !ok {
+			return nil, nil, nil, false, errors.New("case on a value that is not a constructor")
+		}
+		if c.tag >= uint64(len(f.branches)) {
+			return nil, nil, nil, false, fmt.Errorf("no branch for constructor %d", c.tag)
+		}
+		// The branch is applied to the fields, the first one first.
+		for i := len(c.fields) - 1; i >= 0; i-- {
+			m.stack = append(m.stack, frameApplyTo{arg: c.fields[i]})
+		}
+		return f.branches[c.tag], f.env, nil, true, nil
+	default:
+		return nil, nil, nil, false, fmt.Errorf("unknown frame %T", f)
+	}
+}
+
+func (m *machine) apply(fun, arg value) (Term, *env, value, bool, error) {
+	switch f := fun.(type) {
+	case vLambda:
+		return f.body, &env{value: arg, next: f.env}, nil, true, nil
+	case vBuiltin:
+		if f.forces > 0 {
+			return nil, nil, nil, false, fmt.Errorf("builtin %s applied before being forced", f.fn)
+		}
+		args := append(append(make([]value, 0, len(f.args)+1), f.args...), arg)
+		if len(args) < builtinSpecs[f.fn].arity {
+			return nil, nil, vBuiltin{fn: f.fn, args: args}, false, nil
+		}
+		v, err := m.callBuiltin(f.fn, args)
+		return nil, nil, v, false, err
+	default:
+		return nil, nil, nil, false, errors.New("application of a value that is not a function")
+	}
+}
+
+func (m *machine) force(v value) (Term, *env, value, bool, error) {
+	switch v := v.(type) {
+	case vDelay:
+		return v.body, v.env, nil, true, nil
+	case vBuiltin:
+		if v.forces == 0 {
+			return nil, nil, nil, false, fmt.Errorf("builtin %s forced too often", v.fn)
+		}
+		v.forces--
+		return nil, nil, v, false, nil
+	default:
+		return nil, nil, nil, false, errors.New("force of a value that is not delayed")
+	}
+}
+
+// callBuiltin spends the cost of fn on args and runs it.
+func (m *machine) callBuiltin(fn BuiltinFunction, args []value) (value, error) {
+	consts := make([]Constant, 0, len(args))
+	sizes := make([]int64, len(args))
+	for i, a := range args {
+		if c, ok := a.(vCon); ok {
+			consts = append(consts, c.c)
+			sizes[i] = memory(c.c)
+		} else {
+			consts = append(consts, nil)
+			sizes[i] = 1
+		}
+	}
+	cost := builtinCosts[fn]
+	if err := m.spend(Budget{CPU: cost.cpu(sizes), Mem: cost.mem(sizes)}); err != nil {
+		return nil, err
+	}
+	v, err := builtinSpecs[fn].run(m, args, consts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn, err)
+	}
+	return v, nil
+}
+
+//
+// --- Discharge ---
+//
+
+// discharge turns a value back into a term, substituting the variables bound in its
+// environment.
+func discharge(v value) Term {
+	switch v := v.(type) {
+	case vCon:
+		return Const{Value: v.c}
+	case vDelay:
+		return Delay{Term: substitute(v.body, v.env, 0)}
+	case vLambda:
+		return Lambda{Body: substitute(v.body, v.env, 1)}
+	case vBuiltin:
+		var t Term = Builtin{Function: v.fn}
+		for i := builtinSpecs[v.fn].forces; i > v.forces; i-- {
+			t = Force{Term: t}
+		}
+		for _, a := range v.args {
+			t = Apply{Function: t, Argument: discharge(a)}
+		}
+		return t
+	case vConstr:
+		fields := make([]Term, len(v.fields))
+		for i, f := range v.fields {
+			fields[i] = discharge(f)
+		}
+		return Constr{Tag: v.tag, Fields: fields}
+	default:
+		return Error{}
+	}
+}
+
+// substitute replaces the variables of t bound by e, under depth enclosing lambdas.
+func substitute(t Term, e *env, depth uint64) Term {
+	switch t := t.(type) {
+	case Var:
+		if t.Index <= depth {
+			return t
+		}
+		if v, ok := e.lookup(t.Index - depth); ok {
+			return discharge(v)
+		}
+		return t
+	case Delay:
+		return Delay{Term: substitute(t.Term, e, depth)}
+	case Lambda:
+		return Lambda{Body: substitute(t.Body, e, depth+1)}
+	case Apply:
+		return Apply{Function: substitute(t.Function, e, depth), Argument: substitute(t.Argument, e, depth)}
+	case Force:
+		return Force{Term: substitute(t.Term, e, depth)}
+	case Constr:
+		fields := make([]Term, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = substitute(f, e, depth)
+		}
+		return Constr{Tag: t.Tag, Fields: fields}
+	case Case:
+		branches := make([]Term, len(t.Branches))
+		for i, b := range t.Branches {
+			branches[i] = substitute(b, e, depth)
+		}
+		return Case{Scrutinee: substitute(t.Scrutinee, e, depth), Branches: branches}
+	default:
+		return t
+	}
+}
@@ -0,0 +1,191 @@
+package uplc
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+func integer(n int64) Const {
+	return Const{Value: Integer{Value: big.NewInt(n)}}
+}
+
+func apply(f Term, args ...Term) Term {
+	for _, a := range args {
+		f = Apply{Function: f, Argument: a}
+	}
+	return f
+}
+
+func program(t Term) *Program {
+	return &Program{Version: Version{1, 1, 0}, Term: t}
+}
+
+func TestEval(t *testing.T) {
+	dataConst := func(d plutusdata.Data) Const { return Const{Value: Data{Value: d}} }
+	tests := []struct {
+		name string
+		term Term
+		want Term
+	}{
+		{"add", apply(Builtin{Function: addInteger}, integer(1), integer(2)), integer(3)},
+		{"lambda", apply(Lambda{Body: Var{Index: 1}}, integer(5)), integer(5)},
+		{"outer variable", apply(Lambda{Body: Lambda{Body: Var{Index: 2}}}, integer(1), integer(2)), integer(1)},
+		{"if then else", Force{Term: apply(Force{Term: Builtin{Function: ifThenElse}},
+			apply(Builtin{Function: lessThanInteger}, integer(1), integer(2)),
+			Delay{Term: integer(10)}, Delay{Term: Error{}})}, integer(10)},
+		{"case", Case{
+			Scrutinee: Constr{Tag: 1, Fields: []Term{integer(7), integer(8)}},
+			Branches:  []Term{Error{}, Lambda{Body: Lambda{Body: Var{Index: 2}}}},
+		}, integer(7)},
+		{"divide", apply(Builtin{Function: divideInteger}, integer(-7), integer(2)), integer(-4)},
+		{"mod", apply(Builtin{Function: modInteger}, integer(-7), integer(2)), integer(1)},
+		{"quotient", apply(Builtin{Function: quotientInteger}, integer(-7), integer(2)), integer(-3)},
+		{"remainder", apply(Builtin{Function: remainderInteger}, integer(-7), integer(2)), integer(-1)},
+		{"slice", apply(Builtin{Function: sliceByteString}, integer(1), integer(2), Const{Value: ByteString("abcd")}),
+			Const{Value: ByteString("bc")}},
+		{"slice from a negative start", apply(Builtin{Function: sliceByteString}, integer(-2), integer(3), Const{Value: ByteString("abcd")}),
+			Const{Value: ByteString("abc")}},
+		{"slice past the end", apply(Builtin{Function: sliceByteString}, integer(2), integer(100), Const{Value: ByteString("abcd")}),
+			Const{Value: ByteString("cd")}},
+		{"slice of a negative length", apply(Builtin{Function: sliceByteString}, integer(1), integer(-1), Const{Value: ByteString("abcd")}),
+			Const{Value: ByteString{}}},
+		{"data", apply(Builtin{Function: unIData}, apply(Builtin{Function: iData}, integer(9))), integer(9)},
+		{"un constr data", apply(Force{Term: Force{Term: Builtin{Function: fstPair}}},
+			apply(Builtin{Function: unConstrData}, dataConst(plutusdata.Constr{Index: 3}))), integer(3)},
+		{"serialise data", apply(Builtin{Function: serialiseData}, dataConst(plutusdata.NewInteger(1))),
+			Const{Value: ByteString{0x01}}},
+		{"partial application", apply(Builtin{Function: addInteger}, integer(1)),
+			Apply{Function: Builtin{Function: addInteger}, Argument: integer(1)}},
+		{"closure", apply(Lambda{Body: Lambda{Body: Var{Index: 2}}}, integer(4)), Lambda{Body: integer(4)}},
+	}
+	for _, tt := range tests {
+		result, err := Eval(program(tt.term), "v3", DefaultBudget)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(result.Term, tt.want) {
+			t.Errorf("%s = %#v, want %#v", tt.name, result.Term, tt.want)
+		}
+	}
+}
+
+func TestEvalBudget(t *testing.T) {
+	// The startup cost, five steps (two applications, a builtin and two constants) and
+	// addInteger on one-word integers.
+	result, err := Eval(program(apply(Builtin{Function: addInteger}, integer(1), integer(2))), "v3", DefaultBudget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Budget{CPU: 100 + 5*16000 + 100788 + 420, Mem: 100 + 5*100 + 2}
+	if result.Budget != want {
+		t.Errorf("Budget = %+v, want %+v", result.Budget, want)
+	}
+
+	_, err = Eval(program(apply(Builtin{Function: addInteger}, integer(1), integer(2))), "v3", Budget{CPU: 100000, Mem: 1000})
+	if !errors.Is(err, ErrOutOfBudget) {
+		t.Errorf("error = %v, want %v", err, ErrOutOfBudget)
+	}
+
+	// Plutus V2 programs have another cost model.
+	if _, err := Eval(program(integer(1)), "v2", DefaultBudget); err == nil {
+		t.Error("evaluated a Plutus v2 program")
+	}
+}
+
+func TestDivisionBudget(t *testing.T) {
+	words := func(n uint) Const {
+		// An integer of n 64-bit words.
+		return Const{Value: Integer{Value: new(big.Int).Lsh(big.NewInt(1), 64*n-1)}}
+	}
+	// Budgets of the Plutus V3 cost model, where dividing x words by y words costs
+	// 123203 + 1716x + 7305y + 57x² + 549xy - 900y² CPU, at least 85848, and 85848 when
+	// x < y.
+	tests := []struct {
+		name string
+		fn   BuiltinFunction
+		x, y uint
+		cpu  int64
+		mem  int64
+	}{
+		{"one word", divideInteger, 1, 1, 131930, 1},
+		{"large numerator", quotientInteger, 10, 2, 168053, 8},
+		{"large numerator remainder", remainderInteger, 10, 2, 168053, 2},
+		{"larger denominator", modInteger, 1, 2, 85848, 2},
+		{"minimum", divideInteger, 100, 100, 85848, 1},
+	}
+	for _, tt := range tests {
+		result, err := Eval(program(apply(Builtin{Function: tt.fn}, words(tt.x), words(tt.y))), "v3", DefaultBudget)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		want := Budget{CPU: 100 + 5*16000 + tt.cpu, Mem: 100 + 5*100 + tt.mem}
+		if result.Budget != want {
+			t.Errorf("%s: Budget = %+v, want %+v", tt.name, result.Budget, want)
+		}
+	}
+}
+
+func TestEvalTrace(t *testing.T) {
+	term := apply(Force{Term: Builtin{Function: trace}}, Const{Value: String("hello")}, Const{Value: Unit{}})
+	result, err := Eval(program(term), "v3", DefaultBudget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Logs, []string{"hello"}) {
+		t.Errorf("Logs = %v, want [hello]", result.Logs)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		term Term
+		err  string
+	}{
+		{"error term", apply(Lambda{Body: Error{}}, integer(1)), "error term evaluated"},
+		{"division by zero", apply(Builtin{Function: divideInteger}, integer(1), integer(0)), "divideInteger: division by zero"},
+		{"type error", apply(Builtin{Function: addInteger}, integer(1), Const{Value: Unit{}}), "addInteger: argument 2 is not an integer"},
+		{"unforced builtin", apply(Builtin{Function: ifThenElse}, integer(1)), "applied before being forced"},
+		{"free variable", Var{Index: 1}, "free variable 1"},
+		{"missing branch", Case{Scrutinee: Constr{Tag: 2}, Branches: []Term{integer(1)}}, "no branch for constructor 2"},
+		{"head of empty list", apply(Force{Term: Builtin{Function: headList}}, Const{Value: List{Elem: Type{Kind: TypeInteger}}}), "headList: empty list"},
+		{"unsupported builtin", Builtin{Function: bls12_381_G1_add}, "does not support: bls12_381_G1_add"},
+		// Unsupported builtins are rejected before evaluation, even where never reached.
+		{"unreached unsupported builtins", apply(Lambda{Body: integer(1)}, Delay{Term: apply(Builtin{Function: integerToByteString}, Builtin{Function: ripemd_160})}), "does not support: integerToByteString, ripemd_160"},
+	}
+	for _, tt := range tests {
+		_, err := Eval(program(tt.term), "v3", DefaultBudget)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestEvalScript(t *testing.T) {
+	// market.listing.spend succeeds when the redeemer in its script context is the first
+	// constructor.
+	script, err := ParseScript(fixtures[1].compiledCode, "v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index, ok := range map[uint64]bool{0: true, 1: false} {
+		ctx := plutusdata.Constr{Fields: []plutusdata.Data{
+			plutusdata.Constr{},
+			plutusdata.Constr{Index: index},
+			plutusdata.Constr{},
+		}}
+		result, err := Eval(script.ApplyParams(ctx).Program, script.PlutusVersion, DefaultBudget)
+		if ok && (err != nil || !reflect.DeepEqual(result.Term, Const{Value: Unit{}})) {
+			t.Errorf("redeemer %d: result %+v, error %v", index, result, err)
+		}
+		if !ok && err == nil {
+			t.Errorf("redeemer %d: evaluation succeeded", index)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package uplc
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Script language tags, prefixed to a script when hashing it.
+var languageTags = map[string]byte{
+	"v1": 0x01,
+	"v2": 0x02,
+	"v3": 0x03,
+}
+
+// Script is a compiled validator: a program together with its Plutus language version
+// ("v1", "v2" or "v3").
+type Script struct {
+	PlutusVersion string
+	Program       *Program
+}
+
+// ParseScript decodes compiled code as found in a blueprint: the hex encoding of a CBOR
+// byte string holding the flat-encoded program. Code wrapped in a second byte string,
+// as in transaction witnesses, is accepted too.
+func ParseScript(compiledCode, plutusVersion string) (*Script, error) {
+	if _, ok := languageTags[plutusVersion]; !ok {
+		return nil, fmt.Errorf("unknown Plutus version %q", plutusVersion)
+	}
+	code, err := hex.DecodeString(compiledCode)
+	if err != nil {
+		return nil, fmt.Errorf("compiled code is not hex: %w", err)
+	}
+	flat, _, err := UnwrapCBOR(code)
+	if err != nil {
+		return nil, err
+	}
+	program, err := DecodeFlat(flat)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flat program: %w", err)
+	}
+	return &Script{PlutusVersion: plutusVersion, Program: program}, nil
+}
+
+// UnwrapCBOR strips the CBOR byte strings wrapping a flat program, returning the program
+// and the number of wrappings removed, which must be one or two.
+func UnwrapCBOR(code []byte) ([]byte, int, error) {
+	inner, rest, err := plutusdata.DecodeBytes(code)
+	if err != nil {
+		return nil, 0, fmt.Errorf("compiled code is not a CBOR byte string: %w", err)
+	}
+	if len(rest) > 0 {
+		return nil, 0, fmt.Errorf("%d trailing bytes after compiled code", len(rest))
+	}
+	if innermost, rest, err := plutusdata.DecodeBytes(inner); err == nil && len(rest) == 0 {
+		return innermost, 2, nil
+	}
+	return inner, 1, nil
+}
+
+// Bytes returns the script as found in a blueprint: the flat-encoded program wrapped in
+// one CBOR byte string.
+func (s *Script) Bytes() []byte {
+	return wrapCBOR(EncodeFlat(s.Program))
+}
+
+// wrapCBOR wraps flat in a definite-length CBOR byte string. Unlike byte strings in
+// Plutus data, scripts are never split into chunks.
+func wrapCBOR(flat []byte) []byte {
+	n := uint64(len(flat))
+	var head []byte
+	switch {
+	case n < 24:
+		head = []byte{0x40 | byte(n)}
+	case n <= 0xff:
+		head = []byte{0x58, byte(n)}
+	case n <= 0xffff:
+		head = []byte{0x59, byte(n >> 8), byte(n)}
+	default:
+		head = []byte{0x5a, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}
+	}
+	return append(head, flat...)
+}
+
+// CompiledCode returns the hex encoding of Bytes.
+func (s *Script) CompiledCode() string {
+	return hex.EncodeToString(s.Bytes())
+}
+
+// Hash returns the script hash: the blake2b-224 hash of Bytes prefixed with the tag of
+// the script's language.
+func (s *Script) Hash() ([]byte, error) {
+	return ScriptHash(s.Bytes(), s.PlutusVersion)
+}
+
+// CompiledCodeHash returns the hash of compiled code as found in a blueprint. The
+// program is hashed as is, without decoding it, once wrapped in a single byte string.
+func CompiledCodeHash(compiledCode, plutusVersion string) ([]byte, error) {
+	code, err := hex.DecodeString(compiledCode)
+	if err != nil {
+		return nil, fmt.Errorf("compiled code is not hex: %w", err)
+	}
+	flat, _, err := UnwrapCBOR(code)
+	if err != nil {
+		return nil, err
+	}
+	return ScriptHash(wrapCBOR(flat), plutusVersion)
+}
+
+// ScriptHash returns the hash of the script of language plutusVersion whose CBOR-wrapped
+// code is code.
+func ScriptHash(code []byte, plutusVersion string) ([]byte, error) {
+	tag, ok := languageTags[plutusVersion]
+	if !ok {
+		return nil, fmt.Errorf("unknown Plutus version %q", plutusVersion)
+	}
+	h, _ := blake2b.New(28, nil)
+	h.Write([]byte{tag})
+	h.Write(code)
+	return h.Sum(nil), nil
+}
+
+// ApplyParams returns the script applied to the data params, in order.
+func (s *Script) ApplyParams(params ...plutusdata.Data) *Script {
+	constants := make([]Constant, len(params))
+	for i, param := range params {
+		constants[i] = Data{Value: param}
+	}
+	return s.ApplyConstants(constants...)
+}
+
+// ApplyConstants returns the script applied to the constant params, in order. Unlike
+// ApplyParams, it can apply parameters of a builtin type, such as an #integer, which
+// validators take as a constant of that type rather than as data.
+func (s *Script) ApplyConstants(params ...Constant) *Script {
+	term := s.Program.Term
+	for _, param := range params {
+		term = Apply{Function: term, Argument: Const{Value: param}}
+	}
+	return &Script{
+		PlutusVersion: s.PlutusVersion,
+		Program:       &Program{Version: s.Program.Version, Term: term},
+	}
+}
+
+// ApplyParamsCBOR applies the CBOR-encoded data params to compiled code as found in a
+// blueprint, returning the compiled code and hash of the applied script in hex.
+func ApplyParamsCBOR(compiledCode, plutusVersion string, params ...[]byte) (string, string, error) {
+	script, err := ParseScript(compiledCode, plutusVersion)
+	if err != nil {
+		return "", "", err
+	}
+	values := make([]plutusdata.Data, len(params))
+	for i, param := range params {
+		if values[i], err = plutusdata.Decode(param); err != nil {
+			return "", "", fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+	}
+	applied := script.ApplyParams(values...)
+	hash, err := applied.Hash()
+	if err != nil {
+		return "", "", err
+	}
+	return applied.CompiledCode(), hex.EncodeToString(hash), nil
+}
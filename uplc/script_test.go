@@ -0,0 +1,162 @@
+package uplc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// fixtures are compiled validators from testdata/blueprints with their hashes.
+var fixtures = []struct{ compiledCode, hash string }{
+	{"450101002499", "186e32faa80a26810392fda6d559c7ed4721a65ce1c9d4ef3e1c87b4"},
+	{"581f01010025333573466e1cd55ce9baa357426ae88d55cf1baa00148000526161", "99f61e3c23f13561c4b25069c4bd4819cdfd27fa92d57677dce676bc"},
+	{"4701010022224981", "8584a137ba44ecf36aee085b7da213a1825c288743a0a479851905e8"},
+}
+
+func TestParseScript(t *testing.T) {
+	for _, fx := range fixtures {
+		script, err := ParseScript(fx.compiledCode, "v3")
+		if err != nil {
+			t.Fatalf("ParseScript(%s): %v", fx.compiledCode, err)
+		}
+		if script.Program.Version != (Version{1, 1, 0}) {
+			t.Errorf("version = %s, want 1.1.0", script.Program.Version)
+		}
+		if got := script.CompiledCode(); got != fx.compiledCode {
+			t.Errorf("re-encoded %s as %s", fx.compiledCode, got)
+		}
+		hash, err := script.Hash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(hash); got != fx.hash {
+			t.Errorf("Hash() = %s, want %s", got, fx.hash)
+		}
+		// Transaction witnesses wrap the code in a second byte string.
+		double := hex.EncodeToString(wrapCBOR(script.Bytes()))
+		if _, err := ParseScript(double, "v3"); err != nil {
+			t.Errorf("ParseScript(%s): %v", double, err)
+		}
+	}
+}
+
+func TestCompiledCodeHash(t *testing.T) {
+	for _, fx := range fixtures {
+		hash, err := CompiledCodeHash(fx.compiledCode, "v3")
+		if err != nil {
+			t.Fatalf("CompiledCodeHash(%s): %v", fx.compiledCode, err)
+		}
+		if got := hex.EncodeToString(hash); got != fx.hash {
+			t.Errorf("CompiledCodeHash(%s) = %s, want %s", fx.compiledCode, got, fx.hash)
+		}
+	}
+	if _, err := CompiledCodeHash("450101002499", "v1"); err != nil {
+		t.Errorf("CompiledCodeHash for v1: %v", err)
+	}
+	if _, err := CompiledCodeHash("450101002499", "v4"); err == nil {
+		t.Error("CompiledCodeHash accepted an unknown Plutus version")
+	}
+	script := &Script{PlutusVersion: "", Program: program(integer(1))}
+	if _, err := script.Hash(); err == nil {
+		t.Error("Hash accepted a script without a Plutus version")
+	}
+}
+
+func TestLargeScriptBytes(t *testing.T) {
+	// Scripts longer than 64 bytes are wrapped whole, not in chunks like Plutus data.
+	script := &Script{PlutusVersion: "v3", Program: &Program{
+		Version: Version{1, 1, 0},
+		Term:    Const{Value: ByteString(make([]byte, 100))},
+	}}
+	code := script.Bytes()
+	if code[0] != 0x58 || int(code[1]) != len(code)-2 {
+		t.Errorf("Bytes() starts with %x, want a definite byte string of %d bytes", code[:2], len(code)-2)
+	}
+	parsed, err := ParseScript(script.CompiledCode(), "v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed.Program, script.Program) {
+		t.Errorf("round trip gave %+v", parsed.Program)
+	}
+}
+
+func TestParseScriptErrors(t *testing.T) {
+	for _, tt := range []struct{ code, version, err string }{
+		{"450101002499", "v4", `unknown Plutus version "v4"`},
+		{"zz", "v3", "compiled code is not hex: encoding/hex: invalid byte: U+007A 'z'"},
+		{"0101", "v3", "compiled code is not a CBOR byte string: expected a byte string, found major type 0"},
+		{"4401010024", "v3", "invalid flat program: unexpected end of flat input"},
+		{"450101002f01", "v3", "invalid flat program: unknown term tag 15 at bit 28"},
+	} {
+		if _, err := ParseScript(tt.code, tt.version); err == nil || err.Error() != tt.err {
+			t.Errorf("ParseScript(%s) error = %v, want %q", tt.code, err, tt.err)
+		}
+	}
+}
+
+func TestApplyParams(t *testing.T) {
+	script, err := ParseScript("450101002499", "v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied := script.ApplyParams(plutusdata.NewInteger(42))
+	if got, want := applied.CompiledCode(), "4c0101003249930102182a0001"; got != want {
+		t.Errorf("CompiledCode() = %s, want %s", got, want)
+	}
+	code, hash, err := ApplyParamsCBOR("450101002499", "v3", plutusdata.Encode(plutusdata.NewInteger(42)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	appliedHash, err := applied.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != applied.CompiledCode() || hash != hex.EncodeToString(appliedHash) {
+		t.Errorf("ApplyParamsCBOR() = %s, %s, want %s, %x", code, hash, applied.CompiledCode(), appliedHash)
+	}
+}
+
+func TestApplyConstants(t *testing.T) {
+	// (lam n [(builtin addInteger) n (con integer 1)]) only runs with an integer argument.
+	script := &Script{PlutusVersion: "v3", Program: program(Lambda{Body: apply(Builtin{Function: addInteger}, Var{Index: 1}, integer(1))})}
+	result, err := Eval(script.ApplyConstants(Integer{Value: big.NewInt(41)}).Program, "v3", DefaultBudget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Term, integer(42)) {
+		t.Errorf("result = %#v, want 42", result.Term)
+	}
+	if _, err := Eval(script.ApplyParams(plutusdata.NewInteger(41)).Program, "v3", DefaultBudget); err == nil {
+		t.Error("a data argument was accepted as an integer")
+	}
+}
+
+func TestFlatRoundTrip(t *testing.T) {
+	program := &Program{
+		Version: Version{1, 1, 0},
+		Term: Lambda{Body: Case{
+			Scrutinee: Constr{Tag: 300, Fields: []Term{Var{Index: 1}, Const{Value: Integer{Value: big.NewInt(-129)}}}},
+			Branches: []Term{
+				Force{Term: Delay{Term: Error{}}},
+				Apply{Function: Builtin{Function: 26}, Argument: Const{Value: List{Elem: PairOf(Type{Kind: TypeInteger}, Type{Kind: TypeString}), Items: []Constant{
+					Pair{First: Integer{Value: big.NewInt(1)}, Second: String("héllo")},
+				}}}},
+				Const{Value: List{Elem: Type{Kind: TypeBool}, Items: []Constant{}}},
+				Const{Value: ByteString(make([]byte, 300))},
+				Const{Value: Data{Value: plutusdata.Map{{Key: plutusdata.Bytes{1}, Value: plutusdata.List{}}}}},
+				Const{Value: Bool(true)},
+			},
+		}},
+	}
+	decoded, err := DecodeFlat(EncodeFlat(program))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, program) {
+		t.Errorf("round trip changed the program:\n%#v\n%#v", decoded, program)
+	}
+}
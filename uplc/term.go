@@ -0,0 +1,202 @@
+// Package uplc implements Untyped Plutus Core programs: their flat encoding, the CBOR
+// wrapping of compiled scripts, script hashes, and the application of parameters.
+package uplc
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mgpai22/gogenesis/plutusdata"
+)
+
+// Version is the Plutus Core language version of a program, such as 1.1.0.
+type Version struct {
+	Major, Minor, Patch uint64
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Program is a versioned term.
+type Program struct {
+	Version Version
+	Term    Term
+}
+
+// Term is a Plutus Core term: a Var, Delay, Lambda, Apply, Const, Force, Error, Builtin,
+// Constr or Case.
+type Term interface {
+	isTerm()
+}
+
+// Var refers to the variable bound by the Index-th enclosing lambda, counting from 1.
+type Var struct {
+	Index uint64
+}
+
+type Delay struct {
+	Term Term
+}
+
+// Lambda binds a variable in Body.
+type Lambda struct {
+	Body Term
+}
+
+type Apply struct {
+	Function Term
+	Argument Term
+}
+
+type Const struct {
+	Value Constant
+}
+
+type Force struct {
+	Term Term
+}
+
+type Error struct{}
+
+type Builtin struct {
+	Function BuiltinFunction
+}
+
+// Constr applies the constructor with the given tag to fields. It requires Plutus Core
+// 1.1.0.
+type Constr struct {
+	Tag    uint64
+	Fields []Term
+}
+
+// Case selects the branch for the constructor Scrutinee evaluates to. It requires Plutus
+// Core 1.1.0.
+type Case struct {
+	Scrutinee Term
+	Branches  []Term
+}
+
+func (Var) isTerm()     {}
+func (Delay) isTerm()   {}
+func (Lambda) isTerm()  {}
+func (Apply) isTerm()   {}
+func (Const) isTerm()   {}
+func (Force) isTerm()   {}
+func (Error) isTerm()   {}
+func (Builtin) isTerm() {}
+func (Constr) isTerm()  {}
+func (Case) isTerm()    {}
+
+// TypeKind is the head of a constant type. Its value is the tag of the type in the flat
+// encoding.
+type TypeKind uint8
+
+const (
+	TypeInteger    TypeKind = 0
+	TypeByteString TypeKind = 1
+	TypeString     TypeKind = 2
+	TypeUnit       TypeKind = 3
+	TypeBool       TypeKind = 4
+	TypeList       TypeKind = 5
+	TypePair       TypeKind = 6
+	TypeData       TypeKind = 8
+)
+
+// typeApplication is the flat tag applying list and pair to their arguments.
+const typeApplication = 7
+
+// Type is the type of a constant. Lists have their element type as the one argument,
+// pairs the types of their components.
+type Type struct {
+	Kind TypeKind
+	Args []Type
+}
+
+// ListOf returns the type of lists of elem.
+func ListOf(elem Type) Type {
+	return Type{Kind: TypeList, Args: []Type{elem}}
+}
+
+// PairOf returns the type of pairs of first and second.
+func PairOf(first, second Type) Type {
+	return Type{Kind: TypePair, Args: []Type{first, second}}
+}
+
+// Equal reports whether t and u are the same type.
+func (t Type) Equal(u Type) bool {
+	if t.Kind != u.Kind || len(t.Args) != len(u.Args) {
+		return false
+	}
+	for i := range t.Args {
+		if !t.Args[i].Equal(u.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Type) String() string {
+	switch t.Kind {
+	case TypeInteger:
+		return "integer"
+	case TypeByteString:
+		return "bytestring"
+	case TypeString:
+		return "string"
+	case TypeUnit:
+		return "unit"
+	case TypeBool:
+		return "bool"
+	case TypeList:
+		return fmt.Sprintf("(list %s)", t.Args[0])
+	case TypePair:
+		return fmt.Sprintf("(pair %s %s)", t.Args[0], t.Args[1])
+	case TypeData:
+		return "data"
+	default:
+		return fmt.Sprintf("type%d", t.Kind)
+	}
+}
+
+// Constant is a constant value: an Integer, ByteString, String, Unit, Bool, List, Pair
+// or Data.
+type Constant interface {
+	Type() Type
+}
+
+type Integer struct {
+	Value *big.Int
+}
+
+type ByteString []byte
+
+type String string
+
+type Unit struct{}
+
+type Bool bool
+
+// List is a list of constants of type Elem.
+type List struct {
+	Elem  Type
+	Items []Constant
+}
+
+type Pair struct {
+	First  Constant
+	Second Constant
+}
+
+type Data struct {
+	Value plutusdata.Data
+}
+
+func (Integer) Type() Type    { return Type{Kind: TypeInteger} }
+func (ByteString) Type() Type { return Type{Kind: TypeByteString} }
+func (String) Type() Type     { return Type{Kind: TypeString} }
+func (Unit) Type() Type       { return Type{Kind: TypeUnit} }
+func (Bool) Type() Type       { return Type{Kind: TypeBool} }
+func (l List) Type() Type     { return ListOf(l.Elem) }
+func (p Pair) Type() Type     { return PairOf(p.First.Type(), p.Second.Type()) }
+func (Data) Type() Type       { return Type{Kind: TypeData} }